@@ -0,0 +1,150 @@
+/*
+ * package: admin
+ * --------------------
+ * Includes administrative operations (backup and restore) working directly on a ports.ToDoListRepository.
+ */
+
+package admin
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/core/ports"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/logger"
+	"io"
+	"strconv"
+)
+
+const maxRecordSize = 16 * 1024 * 1024
+
+type Conflict struct {
+	Line int    `json:"line"`
+	Id   string `json:"id"`
+}
+
+type RestoreReport struct {
+	Restored     int                `json:"restored"`
+	Replaced     int                `json:"replaced"`
+	Conflicts    []Conflict         `json:"conflicts,omitempty"`
+	ParseErrors  []errs.LineError   `json:"parse_errors,omitempty"`
+	InvalidLists []errs.InvalidList `json:"invalid_lists,omitempty"`
+}
+
+/*
+ * Function: Backup
+ * --------------------
+ * Streams all lists of the repository to w as newline-delimited JSON, one list (including its id) per line.
+ *
+ * ctx: the context.Context of the operation.
+ * w: the io.Writer to write the backup to.
+ * repo: the ports.ToDoListRepository to read the lists from.
+ *
+ * returns: the number of lists written and nil on success.
+ *          Otherwise, the number of lists written so far and a pointer to an errs.AppError are returned.
+ */
+
+func Backup(ctx context.Context, w io.Writer, repo ports.ToDoListRepository) (int, *errs.AppError) {
+	writer := bufio.NewWriter(w)
+	encoder := json.NewEncoder(writer)
+	count := 0
+
+	appErr := repo.ForEach(ctx, func(list domain.ToDoList) error {
+		if err := encoder.Encode(list); err != nil {
+			return err
+		}
+		count++
+		return nil
+	})
+	if appErr != nil {
+		return count, appErr
+	}
+
+	if err := writer.Flush(); err != nil {
+		logger.FromContext(ctx).Error("Error writing backup: " + err.Error())
+		return count, errs.NewInternalError("Error writing backup")
+	}
+	return count, nil
+}
+
+/*
+ * Function: Restore
+ * --------------------
+ * Reads newline-delimited JSON as written by Backup and saves every list with its original id. Lines that cannot
+ * be parsed, lists without id and lists failing validation are skipped and reported. Lists whose id already exists
+ * are replaced if overwrite is true and reported as conflicts (and left untouched) otherwise.
+ *
+ * ctx: the context.Context of the operation.
+ * r: the io.Reader to read the backup from.
+ * repo: the ports.ToDoListRepository to save the lists with.
+ * overwrite: whether existing lists with the same id are to be replaced.
+ *
+ * returns: a pointer to a RestoreReport and nil if the whole input was processed.
+ *          If reading fails or the repository returns an error, processing stops and the report so far is returned
+ *          with a pointer to an errs.AppError.
+ */
+
+func Restore(ctx context.Context, r io.Reader, repo ports.ToDoListRepository, overwrite bool) (*RestoreReport, *errs.AppError) {
+	report := &RestoreReport{}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxRecordSize)
+	line := 0
+	for scanner.Scan() {
+		line++
+		record := bytes.TrimSpace(scanner.Bytes())
+		if len(record) == 0 {
+			continue
+		}
+
+		var list domain.ToDoList
+		decoder := json.NewDecoder(bytes.NewReader(record))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&list); err != nil {
+			report.ParseErrors = append(report.ParseErrors, errs.LineError{Line: line, Message: err.Error()})
+			continue
+		}
+
+		if list.Id.IsZero() {
+			report.InvalidLists = append(report.InvalidLists, errs.InvalidList{
+				Line:          line,
+				Name:          list.Name,
+				InvalidFields: map[string]string{"id": "required"},
+				Violations:    []errs.FieldViolation{errs.NewFieldViolation("id", "required", "", nil, false)},
+			})
+			continue
+		}
+		if validationError := list.Validate(); validationError != nil {
+			report.InvalidLists = append(report.InvalidLists, errs.InvalidList{
+				Line:          line,
+				Name:          list.Name,
+				InvalidFields: validationError.InvalidFields,
+				Violations:    validationError.Violations,
+			})
+			continue
+		}
+
+		existed, appErr := repo.Restore(ctx, list, overwrite)
+		if appErr != nil {
+			return report, appErr
+		}
+		switch {
+		case existed && overwrite:
+			report.Replaced++
+		case existed:
+			report.Conflicts = append(report.Conflicts, Conflict{Line: line, Id: list.Id.Hex()})
+		default:
+			report.Restored++
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		logger.FromContext(ctx).Error("Error reading backup: " + err.Error())
+		return report, errs.NewBadRequestError("Error reading backup in line " + strconv.Itoa(line+1) + ": " + err.Error())
+	}
+
+	return report, nil
+}
@@ -0,0 +1,147 @@
+/*
+ * package: admin
+ * --------------------
+ * Includes administrative operations (backup and restore) working directly on a ports.ToDoListRepository.
+ */
+
+package admin
+
+import (
+	"bytes"
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/testUtils/dummies"
+	"github.com/luschnat-ziegler/toDoListAPI/testUtils/mocks/ports"
+	"strings"
+	"testing"
+)
+
+var mockToDoListRepository *ports.MockToDoListRepository
+
+/*
+ * function: setupBackupTest
+ * --------------------
+ * Sets up variables for tests and returns teardown function.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: a function to clean up test variables.
+ */
+
+func setupBackupTest(t *testing.T) func() {
+	ctrl := gomock.NewController(t)
+	mockToDoListRepository = ports.NewMockToDoListRepository(ctrl)
+	return func() {
+		mockToDoListRepository = nil
+		defer ctrl.Finish()
+	}
+}
+
+/*
+ * function: Test_Backup_should_write_one_json_line_per_list
+ * --------------------
+ * Tests if Backup writes every list passed by the repository as one JSON line including its id.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Backup_should_write_one_json_line_per_list(t *testing.T) {
+	teardown := setupBackupTest(t)
+	defer teardown()
+
+	mockToDoListRepository.EXPECT().ForEach(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fn func(domain.ToDoList) error) error {
+		_ = fn(dummies.DummyListValidWithIds)
+		_ = fn(dummies.DummyListValidWithIds)
+		return nil
+	}).Times(1)
+
+	var buffer bytes.Buffer
+	count, err := Backup(context.Background(), &buffer, mockToDoListRepository)
+
+	if err != nil {
+		t.Fatalf("Nil expected, error returned: %v", err.Message)
+	}
+	if count != 2 {
+		t.Errorf("Expected 2 lists, got %v instead", count)
+	}
+	expected := dummies.DummyListValidWithIdsAsJson + "\n" + dummies.DummyListValidWithIdsAsJson + "\n"
+	if buffer.String() != expected {
+		t.Error("Backup does not match")
+	}
+}
+
+/*
+ * function: Test_Restore_should_report_conflicts_and_invalid_records
+ * --------------------
+ * Tests if Restore saves valid records with their ids and reports existing ids as conflicts as well as unparsable
+ * lines and records without id by line.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Restore_should_report_conflicts_and_invalid_records(t *testing.T) {
+	teardown := setupBackupTest(t)
+	defer teardown()
+
+	gomock.InOrder(
+		mockToDoListRepository.EXPECT().Restore(gomock.Any(), dummies.DummyListValidWithIds, false).Return(false, nil),
+		mockToDoListRepository.EXPECT().Restore(gomock.Any(), dummies.DummyListValidWithIds, false).Return(true, nil),
+	)
+
+	input := strings.Join([]string{
+		dummies.DummyListValidWithIdsAsJson,
+		dummies.DummyRequestInvalidJSON,
+		dummies.DummyValidSaveListRequestAsJSON,
+		"",
+		dummies.DummyListValidWithIdsAsJson,
+	}, "\n")
+
+	report, err := Restore(context.Background(), strings.NewReader(input), mockToDoListRepository, false)
+
+	if err != nil {
+		t.Fatalf("Nil expected, error returned: %v", err.Message)
+	}
+	if report.Restored != 1 || report.Replaced != 0 {
+		t.Errorf("Expected 1 restored list, got %+v instead", report)
+	}
+	if len(report.Conflicts) != 1 || report.Conflicts[0].Line != 5 || report.Conflicts[0].Id != "601be448b9b5e15374b1e842" {
+		t.Errorf("Expected conflict on line 5, got %+v instead", report.Conflicts)
+	}
+	if len(report.ParseErrors) != 1 || report.ParseErrors[0].Line != 2 {
+		t.Errorf("Expected parse error on line 2, got %+v instead", report.ParseErrors)
+	}
+	if len(report.InvalidLists) != 1 || report.InvalidLists[0].Line != 3 || report.InvalidLists[0].InvalidFields["id"] != "required" {
+		t.Errorf("Expected missing id on line 3, got %+v instead", report.InvalidLists)
+	}
+}
+
+/*
+ * function: Test_Restore_should_count_replaced_lists_when_overwriting
+ * --------------------
+ * Tests if Restore passes the overwrite flag to the repository and counts existing lists as replaced.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Restore_should_count_replaced_lists_when_overwriting(t *testing.T) {
+	teardown := setupBackupTest(t)
+	defer teardown()
+
+	mockToDoListRepository.EXPECT().Restore(gomock.Any(), dummies.DummyListValidWithIds, true).Return(true, nil).Times(1)
+
+	report, err := Restore(context.Background(), strings.NewReader(dummies.DummyListValidWithIdsAsJson), mockToDoListRepository, true)
+
+	if err != nil {
+		t.Fatalf("Nil expected, error returned: %v", err.Message)
+	}
+	if report.Replaced != 1 || len(report.Conflicts) != 0 {
+		t.Errorf("Expected 1 replaced list, got %+v instead", report)
+	}
+}
@@ -0,0 +1,241 @@
+/*
+ * package: auth
+ * --------------------
+ * Includes authentication of requests by JWT bearer tokens and static API keys.
+ */
+
+package auth
+
+import (
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/luschnat-ziegler/toDoListAPI/config"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+)
+
+const APIKeyHeader = "X-API-Key"
+
+type Authenticator struct {
+	secret   []byte
+	keys     map[string]*rsa.PublicKey
+	issuer   string
+	audience string
+	apiKeys  map[string]string
+}
+
+type tokenClaims struct {
+	jwt.RegisteredClaims
+	Workspace string `json:"workspace,omitempty"`
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+/*
+ * Function: NewAuthenticator
+ * --------------------
+ * Instantiates an Authenticator accepting HS256 tokens signed with the configured secret, RS256 tokens signed with
+ * a key of the configured JWKS file and the configured API keys.
+ *
+ * settings: the config.Auth to be applied
+ *
+ * returns: a pointer to the Authenticator and nil, or nil and an error if the JWKS file cannot be loaded
+ */
+
+func NewAuthenticator(settings config.Auth) (*Authenticator, error) {
+	authenticator := &Authenticator{
+		issuer:   settings.Issuer,
+		audience: settings.Audience,
+		apiKeys:  settings.APIKeys,
+	}
+	if settings.JWTSecret != "" {
+		authenticator.secret = []byte(settings.JWTSecret)
+	}
+	if settings.JWKSFile != "" {
+		keys, err := loadJWKS(settings.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		authenticator.keys = keys
+	}
+	return authenticator, nil
+}
+
+/*
+ * Function: loadJWKS
+ * --------------------
+ * Reads the RSA signing keys of a JSON Web Key Set (RFC 7517) file. Keys of other types or for encryption are
+ * ignored.
+ *
+ * path: the path of the JWKS file
+ *
+ * returns: the public keys by key id and nil, or nil and an error
+ */
+
+func loadJWKS(path string) (map[string]*rsa.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading jwks file: %w", err)
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("parsing jwks file %s: %w", path, err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, key := range set.Keys {
+		if key.Kty != "RSA" || (key.Use != "" && key.Use != "sig") {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(key.N)
+		if err != nil {
+			return nil, fmt.Errorf("parsing key %q of jwks file %s: %w", key.Kid, path, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(key.E)
+		if err != nil {
+			return nil, fmt.Errorf("parsing key %q of jwks file %s: %w", key.Kid, path, err)
+		}
+		keys[key.Kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("jwks file %s contains no RSA signing keys", path)
+	}
+	return keys, nil
+}
+
+/*
+ * Method: Authenticator.Authenticate
+ * --------------------
+ * Authenticates a request by the bearer token in its Authorization header or the key in its X-API-Key header.
+ *
+ * r: a pointer to the http.Request to be authenticated
+ *
+ * returns: the authenticated Principal and nil on success.
+ *          Otherwise, the zero value and a pointer to an errs.AppError with code 401 are returned.
+ */
+
+func (authenticator *Authenticator) Authenticate(r *http.Request) (Principal, *errs.AppError) {
+	if key := r.Header.Get(APIKeyHeader); key != "" {
+		return authenticator.authenticateAPIKey(key)
+	}
+
+	header := r.Header.Get("Authorization")
+	if header == "" {
+		return Principal{}, errs.Localized(errs.NewUnauthorizedError, errs.MessageAuthRequired)
+	}
+	scheme, token, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return Principal{}, errs.Localized(errs.NewUnauthorizedError, errs.MessageAuthScheme)
+	}
+	return authenticator.authenticateToken(strings.TrimSpace(token))
+}
+
+/*
+ * Method: Authenticator.authenticateAPIKey
+ * --------------------
+ * Looks up the name of an API key. Keys are compared in constant time. The name is prefixed with
+ * APIKeySubjectPrefix, so that keys never share a subject with the users of tokens.
+ *
+ * key: the API key sent by the client
+ *
+ * returns: a Principal with the prefixed name of the key as subject and nil, or the zero value and a pointer to an
+ *          errs.AppError with code 401
+ */
+
+func (authenticator *Authenticator) authenticateAPIKey(key string) (Principal, *errs.AppError) {
+	subject := ""
+	for name, candidate := range authenticator.apiKeys {
+		if subtle.ConstantTimeCompare([]byte(key), []byte(candidate)) == 1 {
+			subject = name
+		}
+	}
+	if subject == "" {
+		return Principal{}, errs.Localized(errs.NewUnauthorizedError, errs.MessageInvalidAPIKey)
+	}
+	return Principal{Subject: APIKeySubjectPrefix + subject, Method: MethodAPIKey}, nil
+}
+
+/*
+ * Method: Authenticator.authenticateToken
+ * --------------------
+ * Verifies signature, expiry and, if configured, issuer and audience of a JWT. The token must carry a subject, which
+ * must not start with APIKeySubjectPrefix, and may carry the workspace its bearer is restricted to (claim
+ * "workspace").
+ *
+ * token: the encoded JWT
+ *
+ * returns: a Principal with the subject and workspace of the token and nil, or the zero value and a pointer to an errs.AppError
+ *          with code 401
+ */
+
+func (authenticator *Authenticator) authenticateToken(token string) (Principal, *errs.AppError) {
+	options := []jwt.ParserOption{jwt.WithValidMethods([]string{"HS256", "RS256"}), jwt.WithExpirationRequired()}
+	if authenticator.issuer != "" {
+		options = append(options, jwt.WithIssuer(authenticator.issuer))
+	}
+	if authenticator.audience != "" {
+		options = append(options, jwt.WithAudience(authenticator.audience))
+	}
+
+	claims := tokenClaims{}
+	if _, err := jwt.ParseWithClaims(token, &claims, authenticator.key, options...); err != nil {
+		return Principal{}, errs.Localized(errs.NewUnauthorizedError, errs.MessageInvalidToken, "reason", err.Error())
+	}
+	if claims.Subject == "" {
+		return Principal{}, errs.Localized(errs.NewUnauthorizedError, errs.MessageTokenSubject)
+	}
+	if strings.HasPrefix(claims.Subject, APIKeySubjectPrefix) {
+		return Principal{}, errs.Localized(errs.NewUnauthorizedError, errs.MessageInvalidToken, "reason", "subject is reserved for API keys")
+	}
+	return Principal{Subject: claims.Subject, Method: MethodJWT, Workspace: claims.Workspace}, nil
+}
+
+/*
+ * Method: Authenticator.key
+ * --------------------
+ * Selects the key to verify a token with: the secret for HS256, the JWKS key named by the kid header for RS256 (or
+ * the only key, if the token names none).
+ *
+ * token: a pointer to the parsed, unverified jwt.Token
+ *
+ * returns: the key and nil, or nil and an error if no key matches
+ */
+
+func (authenticator *Authenticator) key(token *jwt.Token) (interface{}, error) {
+	switch token.Method.Alg() {
+	case "HS256":
+		if authenticator.secret == nil {
+			return nil, errors.New("HS256 tokens are not accepted")
+		}
+		return authenticator.secret, nil
+	case "RS256":
+		kid, _ := token.Header["kid"].(string)
+		if key, ok := authenticator.keys[kid]; ok {
+			return key, nil
+		}
+		if kid == "" && len(authenticator.keys) == 1 {
+			for _, key := range authenticator.keys {
+				return key, nil
+			}
+		}
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+}
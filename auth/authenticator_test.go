@@ -0,0 +1,289 @@
+/*
+ * package: auth
+ * --------------------
+ * Includes authentication of requests by JWT bearer tokens and static API keys.
+ */
+
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/luschnat-ziegler/toDoListAPI/config"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const testSecret = "test-secret"
+
+/*
+ * function: writeJWKS
+ * --------------------
+ * Writes a JWKS file containing the public part of key under the given key id to a temporary directory.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ * key: a pointer to the rsa.PrivateKey whose public key is to be written
+ * kid: the key id
+ *
+ * Returns: the path of the file
+ */
+
+func writeJWKS(t *testing.T, key *rsa.PrivateKey, kid string) string {
+	set := map[string][]map[string]string{
+		"keys": {{
+			"kty": "RSA",
+			"kid": kid,
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	}
+	data, _ := json.Marshal(set)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+/*
+ * function: bearerRequest
+ * --------------------
+ * Instantiates a request carrying the token in its Authorization header.
+ *
+ * token: the encoded JWT
+ *
+ * Returns: a pointer to the http.Request
+ */
+
+func bearerRequest(token string) *http.Request {
+	request, _ := http.NewRequest(http.MethodGet, "/todos", nil)
+	request.Header.Set("Authorization", "Bearer "+token)
+	return request
+}
+
+/*
+ * function: claims
+ * --------------------
+ * Instantiates valid claims for the test issuer and audience.
+ *
+ * subject: the subject of the claims
+ *
+ * Returns: jwt.RegisteredClaims
+ */
+
+func claims(subject string) jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		Subject:   subject,
+		Issuer:    "https://issuer.example",
+		Audience:  jwt.ClaimStrings{"todos"},
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+}
+
+/*
+ * function: Test_Authenticator_should_accept_valid_tokens
+ * --------------------
+ * Tests if HS256 tokens signed with the secret and RS256 tokens signed with a JWKS key are accepted and their
+ * subject returned.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Authenticator_should_accept_valid_tokens(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	authenticator, err := NewAuthenticator(config.Auth{
+		JWTSecret: testSecret,
+		JWKSFile:  writeJWKS(t, privateKey, "key-1"),
+		Issuer:    "https://issuer.example",
+		Audience:  "todos",
+	})
+	if err != nil {
+		t.Fatalf("Expected nil, got error %v instead", err)
+	}
+
+	hs256, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims("alice")).SignedString([]byte(testSecret))
+	rs256Token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims("bob"))
+	rs256Token.Header["kid"] = "key-1"
+	rs256, _ := rs256Token.SignedString(privateKey)
+
+	for token, subject := range map[string]string{hs256: "alice", rs256: "bob"} {
+		principal, appErr := authenticator.Authenticate(bearerRequest(token))
+		if appErr != nil {
+			t.Errorf("Expected nil, got error %v instead", appErr.Message)
+		}
+		if principal.Subject != subject || principal.Method != MethodJWT {
+			t.Errorf("Expected principal %v, got %+v instead", subject, principal)
+		}
+	}
+}
+
+/*
+ * function: Test_Authenticator_should_read_workspace_claim
+ * --------------------
+ * Tests if the workspace claim of a token is passed on in the principal.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Authenticator_should_read_workspace_claim(t *testing.T) {
+	authenticator, _ := NewAuthenticator(config.Auth{JWTSecret: testSecret})
+
+	token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, tokenClaims{
+		RegisteredClaims: claims("alice"),
+		Workspace:        "teama",
+	}).SignedString([]byte(testSecret))
+
+	principal, appErr := authenticator.Authenticate(bearerRequest(token))
+	if appErr != nil {
+		t.Fatalf("Expected nil, got error %v instead", appErr.Message)
+	}
+	if principal.Workspace != "teama" {
+		t.Errorf("Expected workspace teama, got %q instead", principal.Workspace)
+	}
+}
+
+/*
+ * function: Test_Authenticator_should_reject_invalid_tokens
+ * --------------------
+ * Tests if tokens with wrong signature, issuer or audience, expired tokens, tokens without expiry or subject and
+ * requests without credentials are rejected with code 401.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Authenticator_should_reject_invalid_tokens(t *testing.T) {
+	authenticator, _ := NewAuthenticator(config.Auth{
+		JWTSecret: testSecret,
+		Issuer:    "https://issuer.example",
+		Audience:  "todos",
+	})
+
+	sign := func(claims jwt.RegisteredClaims, secret string) string {
+		token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+		return token
+	}
+	wrongIssuer := claims("alice")
+	wrongIssuer.Issuer = "https://other.example"
+	wrongAudience := claims("alice")
+	wrongAudience.Audience = jwt.ClaimStrings{"other"}
+	expired := claims("alice")
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+	noExpiry := claims("alice")
+	noExpiry.ExpiresAt = nil
+
+	requests := map[string]*http.Request{
+		"wrong signature": bearerRequest(sign(claims("alice"), "other-secret")),
+		"wrong issuer":    bearerRequest(sign(wrongIssuer, testSecret)),
+		"wrong audience":  bearerRequest(sign(wrongAudience, testSecret)),
+		"expired":         bearerRequest(sign(expired, testSecret)),
+		"no expiry":       bearerRequest(sign(noExpiry, testSecret)),
+		"no subject":      bearerRequest(sign(claims(""), testSecret)),
+		"malformed":       bearerRequest("not-a-token"),
+	}
+	noCredentials, _ := http.NewRequest(http.MethodGet, "/todos", nil)
+	requests["no credentials"] = noCredentials
+	basic, _ := http.NewRequest(http.MethodGet, "/todos", nil)
+	basic.SetBasicAuth("alice", "password")
+	requests["basic auth"] = basic
+
+	for name, request := range requests {
+		if _, appErr := authenticator.Authenticate(request); appErr == nil || appErr.Code != http.StatusUnauthorized {
+			t.Errorf("Expected code 401 for %s, got %v instead", name, appErr)
+		}
+	}
+}
+
+/*
+ * function: Test_Authenticator_should_authenticate_api_keys
+ * --------------------
+ * Tests if a configured API key is accepted with its prefixed name as subject and unknown keys are rejected.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Authenticator_should_authenticate_api_keys(t *testing.T) {
+	authenticator, _ := NewAuthenticator(config.Auth{APIKeys: map[string]string{"ci": "key-123"}})
+
+	request, _ := http.NewRequest(http.MethodGet, "/todos", nil)
+	request.Header.Set(APIKeyHeader, "key-123")
+	principal, appErr := authenticator.Authenticate(request)
+	if appErr != nil || principal.Subject != "apikey:ci" || principal.Method != MethodAPIKey {
+		t.Errorf("Expected principal apikey:ci, got %+v and %v instead", principal, appErr)
+	}
+
+	request.Header.Set(APIKeyHeader, "key-456")
+	if _, appErr := authenticator.Authenticate(request); appErr == nil || appErr.Code != http.StatusUnauthorized {
+		t.Errorf("Expected code 401, got %v instead", appErr)
+	}
+}
+
+/*
+ * function: Test_Authenticator_should_separate_subjects_of_api_keys_and_tokens
+ * --------------------
+ * Tests if an API key named like the subject of a token is authenticated as a different subject, so that neither
+ * gets access to the lists of the other, and tokens claiming the subject of an API key are rejected.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Authenticator_should_separate_subjects_of_api_keys_and_tokens(t *testing.T) {
+	authenticator, _ := NewAuthenticator(config.Auth{JWTSecret: testSecret, APIKeys: map[string]string{"alice": "key-123"}})
+
+	request, _ := http.NewRequest(http.MethodGet, "/todos", nil)
+	request.Header.Set(APIKeyHeader, "key-123")
+	keyPrincipal, _ := authenticator.Authenticate(request)
+
+	token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims("alice")).SignedString([]byte(testSecret))
+	tokenPrincipal, _ := authenticator.Authenticate(bearerRequest(token))
+
+	if keyPrincipal.Subject == "" || keyPrincipal.Subject == tokenPrincipal.Subject {
+		t.Errorf("Expected different subjects, got %q and %q instead", keyPrincipal.Subject, tokenPrincipal.Subject)
+	}
+
+	token, _ = jwt.NewWithClaims(jwt.SigningMethodHS256, claims(keyPrincipal.Subject)).SignedString([]byte(testSecret))
+	if _, appErr := authenticator.Authenticate(bearerRequest(token)); appErr == nil || appErr.Code != http.StatusUnauthorized {
+		t.Errorf("Expected code 401 for token claiming %s, got %v instead", keyPrincipal.Subject, appErr)
+	}
+}
+
+/*
+ * function: Test_NewAuthenticator_should_reject_jwks_without_rsa_keys
+ * --------------------
+ * Tests if NewAuthenticator returns an error for JWKS files that cannot be read or contain no RSA signing key.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_NewAuthenticator_should_reject_jwks_without_rsa_keys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	_ = os.WriteFile(path, []byte(`{"keys":[{"kty":"oct","k":"c2VjcmV0"}]}`), 0600)
+
+	for _, file := range []string{path, filepath.Join(t.TempDir(), "missing.json")} {
+		if _, err := NewAuthenticator(config.Auth{JWKSFile: file}); err == nil {
+			t.Errorf("Expected error for %s, got nil instead", file)
+		}
+	}
+}
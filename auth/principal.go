@@ -0,0 +1,54 @@
+/*
+ * package: auth
+ * --------------------
+ * Includes authentication of requests by JWT bearer tokens and static API keys.
+ */
+
+package auth
+
+import "context"
+
+const (
+	MethodJWT    = "jwt"
+	MethodAPIKey = "api_key"
+)
+
+const APIKeySubjectPrefix = "apikey:"
+
+type Principal struct {
+	Subject   string `json:"subject"`
+	Method    string `json:"method"`
+	Workspace string `json:"workspace,omitempty"`
+}
+
+type principalKey struct{}
+
+/*
+ * Function: WithPrincipal
+ * --------------------
+ * Stores the authenticated principal in a context.
+ *
+ * ctx: the parent context.Context
+ * principal: the authenticated Principal
+ *
+ * returns: a context.Context carrying the principal
+ */
+
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+/*
+ * Function: PrincipalFromContext
+ * --------------------
+ * Retrieves the principal stored in a context (see WithPrincipal).
+ *
+ * ctx: a context.Context
+ *
+ * returns: the Principal and true, or the zero value and false if the request was not authenticated
+ */
+
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
@@ -0,0 +1,550 @@
+/*
+ * package: config
+ * --------------------
+ * Includes the typed application configuration and its loading from defaults, an optional YAML or TOML file and
+ * environment variables (including those loaded from .env).
+ */
+
+package config
+
+import (
+	"fmt"
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Duration struct {
+	time.Duration
+}
+
+type Server struct {
+	Address         string   `yaml:"address" toml:"address"`
+	ReadTimeout     Duration `yaml:"read_timeout" toml:"read_timeout"`
+	WriteTimeout    Duration `yaml:"write_timeout" toml:"write_timeout"`
+	IdleTimeout     Duration `yaml:"idle_timeout" toml:"idle_timeout"`
+	ShutdownTimeout Duration `yaml:"shutdown_timeout" toml:"shutdown_timeout"`
+	MaxBodyBytes    int      `yaml:"max_body_bytes" toml:"max_body_bytes"`
+	TrustedProxies  []string `yaml:"trusted_proxies" toml:"trusted_proxies"`
+}
+
+type Database struct {
+	URL                 string   `yaml:"url" toml:"url"`
+	Name                string   `yaml:"name" toml:"name"`
+	Collection          string   `yaml:"collection" toml:"collection"`
+	WorkspaceCollection string   `yaml:"workspace_collection" toml:"workspace_collection"`
+	ShareLinkCollection string   `yaml:"share_link_collection" toml:"share_link_collection"`
+	PerWorkspace        bool     `yaml:"per_workspace" toml:"per_workspace"`
+	Timeout             Duration `yaml:"timeout" toml:"timeout"`
+}
+
+type Log struct {
+	Level              string `yaml:"level" toml:"level"`
+	Format             string `yaml:"format" toml:"format"`
+	File               string `yaml:"file" toml:"file"`
+	MaxSizeMB          int    `yaml:"max_size_mb" toml:"max_size_mb"`
+	MaxBackups         int    `yaml:"max_backups" toml:"max_backups"`
+	MaxAgeDays         int    `yaml:"max_age_days" toml:"max_age_days"`
+	SamplingInitial    int    `yaml:"sampling_initial" toml:"sampling_initial"`
+	SamplingThereafter int    `yaml:"sampling_thereafter" toml:"sampling_thereafter"`
+}
+
+type Tracing struct {
+	Exporter    string  `yaml:"exporter" toml:"exporter"`
+	Endpoint    string  `yaml:"endpoint" toml:"endpoint"`
+	SampleRatio float64 `yaml:"sample_ratio" toml:"sample_ratio"`
+}
+
+type Auth struct {
+	Enabled   bool              `yaml:"enabled" toml:"enabled"`
+	JWTSecret string            `yaml:"jwt_secret" toml:"jwt_secret"`
+	JWKSFile  string            `yaml:"jwks_file" toml:"jwks_file"`
+	Issuer    string            `yaml:"issuer" toml:"issuer"`
+	Audience  string            `yaml:"audience" toml:"audience"`
+	APIKeys   map[string]string `yaml:"api_keys" toml:"api_keys"`
+}
+
+type Limit struct {
+	PerMinute int `yaml:"per_minute" toml:"per_minute"`
+	Burst     int `yaml:"burst" toml:"burst"`
+}
+
+type RateLimit struct {
+	Enabled bool  `yaml:"enabled" toml:"enabled"`
+	Read    Limit `yaml:"read" toml:"read"`
+	Write   Limit `yaml:"write" toml:"write"`
+	Public  Limit `yaml:"public" toml:"public"`
+	Auth    Limit `yaml:"auth" toml:"auth"`
+}
+
+type CORS struct {
+	AllowedOrigins   []string `yaml:"allowed_origins" toml:"allowed_origins"`
+	AllowedMethods   []string `yaml:"allowed_methods" toml:"allowed_methods"`
+	AllowedHeaders   []string `yaml:"allowed_headers" toml:"allowed_headers"`
+	ExposedHeaders   []string `yaml:"exposed_headers" toml:"exposed_headers"`
+	AllowCredentials bool     `yaml:"allow_credentials" toml:"allow_credentials"`
+	MaxAge           Duration `yaml:"max_age" toml:"max_age"`
+}
+
+type Storage struct {
+	Backend string `yaml:"backend" toml:"backend"`
+}
+
+type Config struct {
+	Server    Server    `yaml:"server" toml:"server"`
+	Database  Database  `yaml:"database" toml:"database"`
+	Log       Log       `yaml:"log" toml:"log"`
+	Tracing   Tracing   `yaml:"tracing" toml:"tracing"`
+	Auth      Auth      `yaml:"auth" toml:"auth"`
+	RateLimit RateLimit `yaml:"rate_limit" toml:"rate_limit"`
+	CORS      CORS      `yaml:"cors" toml:"cors"`
+	Storage   Storage   `yaml:"storage" toml:"storage"`
+}
+
+var logLevels = []string{"debug", "info", "warn", "error"}
+var logFormats = []string{"json", "console"}
+var tracingExporters = []string{"none", "stdout", "otlp"}
+var storageBackends = []string{"mongo"}
+
+/*
+ * Function: Default
+ * --------------------
+ * Instantiates a Config with the default settings. The database URL has no default.
+ *
+ * returns: a Config.
+ */
+
+func Default() Config {
+	return Config{
+		Server: Server{
+			Address:         ":8000",
+			ReadTimeout:     Duration{10 * time.Second},
+			WriteTimeout:    Duration{30 * time.Second},
+			IdleTimeout:     Duration{60 * time.Second},
+			ShutdownTimeout: Duration{15 * time.Second},
+			MaxBodyBytes:    1 << 20,
+		},
+		Database: Database{
+			Name:                "todo",
+			Collection:          "lists",
+			WorkspaceCollection: "workspaces",
+			ShareLinkCollection: "shareLinks",
+			Timeout:             Duration{5 * time.Second},
+		},
+		Log: Log{
+			Level:              "info",
+			Format:             "json",
+			MaxSizeMB:          100,
+			MaxBackups:         5,
+			MaxAgeDays:         30,
+			SamplingInitial:    100,
+			SamplingThereafter: 100,
+		},
+		Tracing: Tracing{
+			Exporter:    "none",
+			Endpoint:    "http://localhost:4318",
+			SampleRatio: 1,
+		},
+		RateLimit: RateLimit{
+			Enabled: true,
+			Read:    Limit{PerMinute: 600, Burst: 100},
+			Write:   Limit{PerMinute: 120, Burst: 30},
+			Public:  Limit{PerMinute: 60, Burst: 20},
+			Auth:    Limit{PerMinute: 1200, Burst: 200},
+		},
+		CORS: CORS{
+			AllowedMethods: []string{"GET", "POST", "PUT", "DELETE"},
+			AllowedHeaders: []string{"Accept", "Authorization", "Content-Type", "If-None-Match", "X-API-Key", "X-Request-ID", "X-Workspace-ID"},
+			ExposedHeaders: []string{"Content-Disposition", "ETag", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "Retry-After", "X-Request-ID"},
+			MaxAge:         Duration{10 * time.Minute},
+		},
+		Storage: Storage{
+			Backend: "mongo",
+		},
+	}
+}
+
+/*
+ * Function: Load
+ * --------------------
+ * Loads the configuration. Defaults are overridden by the file named in the environment variable CONFIG_FILE
+ * (YAML or TOML, depending on its extension), if set, which in turn is overridden by environment variables
+ * (see applyEnv). The result is validated.
+ *
+ * returns: the loaded Config and nil on success.
+ *          Otherwise, the zero value and an error describing the problem are returned.
+ */
+
+func Load() (Config, error) {
+	cfg := Default()
+
+	if path, ok := os.LookupEnv("CONFIG_FILE"); ok && path != "" {
+		if err := loadFile(path, &cfg); err != nil {
+			return Config{}, err
+		}
+	}
+
+	if err := applyEnv(&cfg); err != nil {
+		return Config{}, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+/*
+ * Function: loadFile
+ * --------------------
+ * Reads a YAML (.yaml, .yml) or TOML (.toml) configuration file into cfg. Settings absent from the file are kept.
+ *
+ * path: the path of the configuration file.
+ * cfg: a pointer to the Config to be overridden.
+ *
+ * returns: an error if the file cannot be read or parsed, nil otherwise.
+ */
+
+func loadFile(path string, cfg *Config) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading config file: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, cfg)
+	case ".toml":
+		err = toml.Unmarshal(data, cfg)
+	default:
+		return fmt.Errorf("unsupported config file %s, expected .yaml, .yml or .toml", path)
+	}
+	if err != nil {
+		return fmt.Errorf("parsing config file %s: %w", path, err)
+	}
+	return nil
+}
+
+/*
+ * Function: applyEnv
+ * --------------------
+ * Overrides settings of cfg with the environment variables LISTEN_ADDRESS, SERVER_READ_TIMEOUT, SERVER_WRITE_TIMEOUT,
+ * SERVER_IDLE_TIMEOUT, SERVER_SHUTDOWN_TIMEOUT, SERVER_MAX_BODY_BYTES, SERVER_TRUSTED_PROXIES, DB_URL, DB_NAME,
+ * DB_COLLECTION, DB_WORKSPACE_COLLECTION, DB_SHARE_LINK_COLLECTION, DB_PER_WORKSPACE, DB_TIMEOUT, LOG_LEVEL,
+ * LOG_FORMAT, LOG_FILE, LOG_MAX_SIZE_MB, LOG_MAX_BACKUPS, LOG_MAX_AGE_DAYS, LOG_SAMPLING_INITIAL,
+ * LOG_SAMPLING_THEREAFTER, TRACING_EXPORTER, TRACING_ENDPOINT, TRACING_SAMPLE_RATIO, AUTH_ENABLED, AUTH_JWT_SECRET,
+ * AUTH_JWKS_FILE, AUTH_ISSUER, AUTH_AUDIENCE, AUTH_API_KEYS, RATE_LIMIT_ENABLED,
+ * RATE_LIMIT_{READ,WRITE,PUBLIC,AUTH}_PER_MINUTE, RATE_LIMIT_{READ,WRITE,PUBLIC,AUTH}_BURST, CORS_ALLOWED_ORIGINS,
+ * CORS_ALLOWED_METHODS, CORS_ALLOWED_HEADERS, CORS_EXPOSED_HEADERS, CORS_ALLOW_CREDENTIALS, CORS_MAX_AGE and
+ * STORAGE_BACKEND, if set. AUTH_API_KEYS is a comma-separated list of name:key pairs, the trusted proxies and CORS
+ * lists are comma-separated as well.
+ *
+ * cfg: a pointer to the Config to be overridden.
+ *
+ * returns: an error if a duration, number, boolean or API key list cannot be parsed, nil otherwise.
+ */
+
+func applyEnv(cfg *Config) error {
+	stringSettings := map[string]*string{
+		"LISTEN_ADDRESS":           &cfg.Server.Address,
+		"DB_URL":                   &cfg.Database.URL,
+		"DB_NAME":                  &cfg.Database.Name,
+		"DB_COLLECTION":            &cfg.Database.Collection,
+		"DB_WORKSPACE_COLLECTION":  &cfg.Database.WorkspaceCollection,
+		"DB_SHARE_LINK_COLLECTION": &cfg.Database.ShareLinkCollection,
+		"LOG_LEVEL":                &cfg.Log.Level,
+		"LOG_FORMAT":               &cfg.Log.Format,
+		"LOG_FILE":                 &cfg.Log.File,
+		"TRACING_EXPORTER":         &cfg.Tracing.Exporter,
+		"TRACING_ENDPOINT":         &cfg.Tracing.Endpoint,
+		"AUTH_JWT_SECRET":          &cfg.Auth.JWTSecret,
+		"AUTH_JWKS_FILE":           &cfg.Auth.JWKSFile,
+		"AUTH_ISSUER":              &cfg.Auth.Issuer,
+		"AUTH_AUDIENCE":            &cfg.Auth.Audience,
+		"STORAGE_BACKEND":          &cfg.Storage.Backend,
+	}
+	for name, target := range stringSettings {
+		if value, ok := os.LookupEnv(name); ok {
+			*target = value
+		}
+	}
+
+	durationSettings := map[string]*Duration{
+		"SERVER_READ_TIMEOUT":     &cfg.Server.ReadTimeout,
+		"SERVER_WRITE_TIMEOUT":    &cfg.Server.WriteTimeout,
+		"SERVER_IDLE_TIMEOUT":     &cfg.Server.IdleTimeout,
+		"SERVER_SHUTDOWN_TIMEOUT": &cfg.Server.ShutdownTimeout,
+		"DB_TIMEOUT":              &cfg.Database.Timeout,
+		"CORS_MAX_AGE":            &cfg.CORS.MaxAge,
+	}
+	for name, target := range durationSettings {
+		if value, ok := os.LookupEnv(name); ok {
+			if err := target.UnmarshalText([]byte(value)); err != nil {
+				return fmt.Errorf("environment variable %s: %w", name, err)
+			}
+		}
+	}
+
+	intSettings := map[string]*int{
+		"SERVER_MAX_BODY_BYTES":        &cfg.Server.MaxBodyBytes,
+		"LOG_MAX_SIZE_MB":              &cfg.Log.MaxSizeMB,
+		"LOG_MAX_BACKUPS":              &cfg.Log.MaxBackups,
+		"LOG_MAX_AGE_DAYS":             &cfg.Log.MaxAgeDays,
+		"LOG_SAMPLING_INITIAL":         &cfg.Log.SamplingInitial,
+		"LOG_SAMPLING_THEREAFTER":      &cfg.Log.SamplingThereafter,
+		"RATE_LIMIT_READ_PER_MINUTE":   &cfg.RateLimit.Read.PerMinute,
+		"RATE_LIMIT_READ_BURST":        &cfg.RateLimit.Read.Burst,
+		"RATE_LIMIT_WRITE_PER_MINUTE":  &cfg.RateLimit.Write.PerMinute,
+		"RATE_LIMIT_WRITE_BURST":       &cfg.RateLimit.Write.Burst,
+		"RATE_LIMIT_PUBLIC_PER_MINUTE": &cfg.RateLimit.Public.PerMinute,
+		"RATE_LIMIT_PUBLIC_BURST":      &cfg.RateLimit.Public.Burst,
+		"RATE_LIMIT_AUTH_PER_MINUTE":   &cfg.RateLimit.Auth.PerMinute,
+		"RATE_LIMIT_AUTH_BURST":        &cfg.RateLimit.Auth.Burst,
+	}
+	for name, target := range intSettings {
+		if value, ok := os.LookupEnv(name); ok {
+			parsed, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("environment variable %s: %w", name, err)
+			}
+			*target = parsed
+		}
+	}
+
+	if value, ok := os.LookupEnv("TRACING_SAMPLE_RATIO"); ok {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("environment variable TRACING_SAMPLE_RATIO: %w", err)
+		}
+		cfg.Tracing.SampleRatio = parsed
+	}
+
+	boolSettings := map[string]*bool{
+		"DB_PER_WORKSPACE":       &cfg.Database.PerWorkspace,
+		"AUTH_ENABLED":           &cfg.Auth.Enabled,
+		"RATE_LIMIT_ENABLED":     &cfg.RateLimit.Enabled,
+		"CORS_ALLOW_CREDENTIALS": &cfg.CORS.AllowCredentials,
+	}
+	for name, target := range boolSettings {
+		if value, ok := os.LookupEnv(name); ok {
+			parsed, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("environment variable %s: %w", name, err)
+			}
+			*target = parsed
+		}
+	}
+
+	listSettings := map[string]*[]string{
+		"SERVER_TRUSTED_PROXIES": &cfg.Server.TrustedProxies,
+		"CORS_ALLOWED_ORIGINS":   &cfg.CORS.AllowedOrigins,
+		"CORS_ALLOWED_METHODS":   &cfg.CORS.AllowedMethods,
+		"CORS_ALLOWED_HEADERS":   &cfg.CORS.AllowedHeaders,
+		"CORS_EXPOSED_HEADERS":   &cfg.CORS.ExposedHeaders,
+	}
+	for name, target := range listSettings {
+		if value, ok := os.LookupEnv(name); ok {
+			var values []string
+			for _, item := range strings.Split(value, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					values = append(values, item)
+				}
+			}
+			*target = values
+		}
+	}
+
+	if value, ok := os.LookupEnv("AUTH_API_KEYS"); ok {
+		apiKeys := make(map[string]string)
+		for _, pair := range strings.Split(value, ",") {
+			if strings.TrimSpace(pair) == "" {
+				continue
+			}
+			name, key, found := strings.Cut(pair, ":")
+			if !found || strings.TrimSpace(name) == "" || strings.TrimSpace(key) == "" {
+				return fmt.Errorf("environment variable AUTH_API_KEYS: expected name:key pairs, got %q", pair)
+			}
+			apiKeys[strings.TrimSpace(name)] = strings.TrimSpace(key)
+		}
+		cfg.Auth.APIKeys = apiKeys
+	}
+	return nil
+}
+
+/*
+ * Method: Config.Validate
+ * --------------------
+ * Checks the configuration for missing or invalid settings.
+ *
+ * returns: an error listing all problems, or nil if the configuration is valid.
+ */
+
+func (cfg Config) Validate() error {
+	var problems []string
+
+	if cfg.Server.Address == "" {
+		problems = append(problems, "server address must not be empty")
+	}
+	if cfg.Server.ReadTimeout.Duration <= 0 || cfg.Server.WriteTimeout.Duration <= 0 || cfg.Server.IdleTimeout.Duration <= 0 {
+		problems = append(problems, "server read, write and idle timeouts must be positive")
+	}
+	if cfg.Server.ShutdownTimeout.Duration <= 0 {
+		problems = append(problems, "server shutdown timeout must be positive")
+	}
+	if cfg.Server.MaxBodyBytes <= 0 {
+		problems = append(problems, "server max body bytes must be positive")
+	}
+	if _, err := cfg.Server.TrustedNetworks(); err != nil {
+		problems = append(problems, err.Error())
+	}
+	if !contains(storageBackends, cfg.Storage.Backend) {
+		problems = append(problems, fmt.Sprintf("storage backend %q not supported, expected one of: %s", cfg.Storage.Backend, strings.Join(storageBackends, ", ")))
+	}
+	if cfg.Storage.Backend == "mongo" {
+		if cfg.Database.URL == "" {
+			problems = append(problems, "database url must be set (DB_URL)")
+		}
+		if cfg.Database.Name == "" {
+			problems = append(problems, "database name must not be empty")
+		}
+		if cfg.Database.Collection == "" || cfg.Database.WorkspaceCollection == "" || cfg.Database.ShareLinkCollection == "" {
+			problems = append(problems, "database collections must not be empty")
+		}
+		if cfg.Database.Timeout.Duration <= 0 {
+			problems = append(problems, "database timeout must be positive")
+		}
+	}
+	if !contains(logLevels, cfg.Log.Level) {
+		problems = append(problems, fmt.Sprintf("log level %q not supported, expected one of: %s", cfg.Log.Level, strings.Join(logLevels, ", ")))
+	}
+	if !contains(logFormats, cfg.Log.Format) {
+		problems = append(problems, fmt.Sprintf("log format %q not supported, expected one of: %s", cfg.Log.Format, strings.Join(logFormats, ", ")))
+	}
+	if cfg.Log.MaxSizeMB < 0 || cfg.Log.MaxBackups < 0 || cfg.Log.MaxAgeDays < 0 {
+		problems = append(problems, "log rotation settings must not be negative")
+	}
+	if cfg.Log.SamplingInitial < 0 || cfg.Log.SamplingThereafter < 0 {
+		problems = append(problems, "log sampling settings must not be negative")
+	}
+	if !contains(tracingExporters, cfg.Tracing.Exporter) {
+		problems = append(problems, fmt.Sprintf("tracing exporter %q not supported, expected one of: %s", cfg.Tracing.Exporter, strings.Join(tracingExporters, ", ")))
+	}
+	if cfg.Tracing.Exporter == "otlp" && cfg.Tracing.Endpoint == "" {
+		problems = append(problems, "tracing endpoint must be set for the otlp exporter (TRACING_ENDPOINT)")
+	}
+	if cfg.Tracing.SampleRatio < 0 || cfg.Tracing.SampleRatio > 1 {
+		problems = append(problems, "tracing sample ratio must be between 0 and 1")
+	}
+	if cfg.Auth.Enabled && cfg.Auth.JWTSecret == "" && cfg.Auth.JWKSFile == "" && len(cfg.Auth.APIKeys) == 0 {
+		problems = append(problems, "authentication requires a jwt secret, a jwks file or api keys")
+	}
+	if cfg.RateLimit.Enabled {
+		for _, limit := range []Limit{cfg.RateLimit.Read, cfg.RateLimit.Write, cfg.RateLimit.Public, cfg.RateLimit.Auth} {
+			if limit.PerMinute <= 0 || limit.Burst <= 0 {
+				problems = append(problems, "rate limits and bursts must be positive")
+				break
+			}
+		}
+	}
+	if cfg.CORS.AllowCredentials && contains(cfg.CORS.AllowedOrigins, "*") {
+		problems = append(problems, "cors credentials can not be allowed for any origin (*)")
+	}
+	if cfg.CORS.MaxAge.Duration < 0 {
+		problems = append(problems, "cors max age must not be negative")
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid configuration: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+/*
+ * Method: Server.TrustedNetworks
+ * --------------------
+ * Parses the trusted proxies, given as IP addresses (e.g. "10.0.0.1") or networks in CIDR notation
+ * (e.g. "10.0.0.0/8").
+ *
+ * returns: the networks and nil on success.
+ *          Otherwise, nil and an error naming the invalid entry are returned.
+ */
+
+func (server Server) TrustedNetworks() ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(server.TrustedProxies))
+	for _, proxy := range server.TrustedProxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("server trusted proxy %q is neither an ip address nor a cidr network", proxy)
+			}
+			if ip4 := ip.To4(); ip4 != nil {
+				ip = ip4
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("server trusted proxy %q is neither an ip address nor a cidr network", proxy)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+/*
+ * Method: Duration.UnmarshalText
+ * --------------------
+ * Parses a duration string such as "5s" or "1m30s" (see time.ParseDuration). Used for YAML, TOML and environment
+ * variables alike.
+ *
+ * text: the duration string.
+ *
+ * returns: an error if the string cannot be parsed, nil otherwise.
+ */
+
+func (duration *Duration) UnmarshalText(text []byte) error {
+	parsed, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	duration.Duration = parsed
+	return nil
+}
+
+/*
+ * Method: Duration.MarshalText
+ * --------------------
+ * Formats the duration as string, e.g. "5s".
+ *
+ * returns: the formatted duration and nil.
+ */
+
+func (duration Duration) MarshalText() ([]byte, error) {
+	return []byte(duration.String()), nil
+}
+
+/*
+ * Function: contains
+ * --------------------
+ * Checks whether a slice of strings contains a value.
+ *
+ * values: the slice to be searched.
+ * value: the value to search for.
+ *
+ * returns: true if value is contained, false otherwise.
+ */
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,367 @@
+/*
+ * package: config
+ * --------------------
+ * Includes the typed application configuration and its loading from defaults, an optional YAML or TOML file and
+ * environment variables (including those loaded from .env).
+ */
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+/*
+ * function: writeConfigFile
+ * --------------------
+ * Writes a configuration file to a temporary directory and points CONFIG_FILE at it.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ * name: the file name, determining the format.
+ * content: the file content.
+ *
+ * Returns: nothing
+ */
+
+func writeConfigFile(t *testing.T, name string, content string) {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("CONFIG_FILE", path)
+}
+
+/*
+ * function: Test_Load_should_apply_defaults_and_environment_variables
+ * --------------------
+ * Tests if Load keeps defaults for unset settings and applies environment variables.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Load_should_apply_defaults_and_environment_variables(t *testing.T) {
+	t.Setenv("DB_URL", "mongodb://localhost:27017")
+	t.Setenv("DB_TIMEOUT", "10s")
+	t.Setenv("LISTEN_ADDRESS", ":9000")
+
+	cfg, err := Load()
+
+	if err != nil {
+		t.Fatalf("Expected nil, got error %v instead", err)
+	}
+	if cfg.Server.Address != ":9000" || cfg.Database.URL != "mongodb://localhost:27017" {
+		t.Errorf("Environment variables not applied, got %+v", cfg)
+	}
+	if cfg.Database.Timeout.Duration != 10*time.Second {
+		t.Errorf("Expected timeout 10s, got %v instead", cfg.Database.Timeout)
+	}
+	if cfg.Database.Name != "todo" || cfg.Database.Collection != "lists" || cfg.Log.Level != "info" {
+		t.Errorf("Defaults not applied, got %+v", cfg)
+	}
+}
+
+/*
+ * function: Test_Load_should_read_yaml_file_overridden_by_environment
+ * --------------------
+ * Tests if Load reads a YAML file and lets environment variables take precedence over it.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Load_should_read_yaml_file_overridden_by_environment(t *testing.T) {
+	writeConfigFile(t, "config.yaml", `
+server:
+  address: ":8080"
+database:
+  url: mongodb://db:27017
+  name: todo_test
+  timeout: 2s
+log:
+  level: debug
+`)
+	t.Setenv("DB_NAME", "todo_env")
+
+	cfg, err := Load()
+
+	if err != nil {
+		t.Fatalf("Expected nil, got error %v instead", err)
+	}
+	if cfg.Server.Address != ":8080" || cfg.Database.Timeout.Duration != 2*time.Second || cfg.Log.Level != "debug" {
+		t.Errorf("File settings not applied, got %+v", cfg)
+	}
+	if cfg.Database.Name != "todo_env" {
+		t.Errorf("Expected environment to override file, got %v", cfg.Database.Name)
+	}
+	if cfg.Database.Collection != "lists" {
+		t.Errorf("Expected default collection, got %v", cfg.Database.Collection)
+	}
+}
+
+/*
+ * function: Test_Load_should_read_toml_file
+ * --------------------
+ * Tests if Load reads a TOML file.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Load_should_read_toml_file(t *testing.T) {
+	writeConfigFile(t, "config.toml", `
+[database]
+url = "mongodb://db:27017"
+collection = "lists_test"
+timeout = "3s"
+`)
+
+	cfg, err := Load()
+
+	if err != nil {
+		t.Fatalf("Expected nil, got error %v instead", err)
+	}
+	if cfg.Database.Collection != "lists_test" || cfg.Database.Timeout.Duration != 3*time.Second {
+		t.Errorf("File settings not applied, got %+v", cfg)
+	}
+}
+
+/*
+ * function: Test_Load_should_report_all_invalid_settings
+ * --------------------
+ * Tests if Load fails with an error naming every invalid setting.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Load_should_report_all_invalid_settings(t *testing.T) {
+	t.Setenv("DB_URL", "")
+	t.Setenv("LOG_LEVEL", "verbose")
+	t.Setenv("LOG_FORMAT", "xml")
+	t.Setenv("TRACING_EXPORTER", "jaeger")
+	t.Setenv("STORAGE_BACKEND", "mongo")
+
+	_, err := Load()
+
+	if err == nil {
+		t.Fatal("Expected error, got nil instead")
+	}
+	for _, expected := range []string{"database url", "log level", "log format", "tracing exporter"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected error to mention %s, got %v", expected, err)
+		}
+	}
+}
+
+/*
+ * function: Test_Load_should_apply_log_settings_from_environment
+ * --------------------
+ * Tests if Load applies string and numeric log settings from environment variables and rejects numbers that cannot
+ * be parsed.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Load_should_apply_log_settings_from_environment(t *testing.T) {
+	t.Setenv("DB_URL", "mongodb://localhost:27017")
+	t.Setenv("LOG_FORMAT", "console")
+	t.Setenv("LOG_FILE", "/var/log/todo.log")
+	t.Setenv("LOG_MAX_BACKUPS", "2")
+	t.Setenv("LOG_SAMPLING_INITIAL", "0")
+
+	cfg, err := Load()
+
+	if err != nil {
+		t.Fatalf("Expected nil, got error %v instead", err)
+	}
+	if cfg.Log.Format != "console" || cfg.Log.File != "/var/log/todo.log" || cfg.Log.MaxBackups != 2 || cfg.Log.SamplingInitial != 0 {
+		t.Errorf("Log settings not applied, got %+v", cfg.Log)
+	}
+	if cfg.Log.MaxSizeMB != 100 || cfg.Log.SamplingThereafter != 100 {
+		t.Errorf("Log defaults not kept, got %+v", cfg.Log)
+	}
+
+	t.Setenv("LOG_MAX_AGE_DAYS", "a week")
+
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), "LOG_MAX_AGE_DAYS") {
+		t.Errorf("Expected error mentioning LOG_MAX_AGE_DAYS, got %v instead", err)
+	}
+}
+
+/*
+ * function: Test_Load_should_parse_api_keys_from_environment
+ * --------------------
+ * Tests if Load parses AUTH_API_KEYS as name:key pairs and rejects malformed pairs as well as enabled
+ * authentication without any credentials configured.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Load_should_parse_api_keys_from_environment(t *testing.T) {
+	t.Setenv("DB_URL", "mongodb://localhost:27017")
+	t.Setenv("AUTH_ENABLED", "true")
+	t.Setenv("AUTH_API_KEYS", "ci:key-123, backup : key-456")
+
+	cfg, err := Load()
+
+	if err != nil {
+		t.Fatalf("Expected nil, got error %v instead", err)
+	}
+	if !cfg.Auth.Enabled || len(cfg.Auth.APIKeys) != 2 || cfg.Auth.APIKeys["ci"] != "key-123" || cfg.Auth.APIKeys["backup"] != "key-456" {
+		t.Errorf("Auth settings not applied, got %+v", cfg.Auth)
+	}
+
+	t.Setenv("AUTH_API_KEYS", "key-without-name")
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), "AUTH_API_KEYS") {
+		t.Errorf("Expected error mentioning AUTH_API_KEYS, got %v instead", err)
+	}
+
+	t.Setenv("AUTH_API_KEYS", "")
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), "authentication requires") {
+		t.Errorf("Expected error about missing credentials, got %v instead", err)
+	}
+}
+
+/*
+ * function: Test_Load_should_apply_workspace_database_settings_from_environment
+ * --------------------
+ * Tests if Load applies DB_PER_WORKSPACE and DB_WORKSPACE_COLLECTION and rejects non-boolean values.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Load_should_apply_workspace_database_settings_from_environment(t *testing.T) {
+	t.Setenv("DB_URL", "mongodb://localhost:27017")
+	t.Setenv("DB_PER_WORKSPACE", "true")
+	t.Setenv("DB_WORKSPACE_COLLECTION", "tenants")
+
+	cfg, err := Load()
+
+	if err != nil {
+		t.Fatalf("Expected nil, got error %v instead", err)
+	}
+	if !cfg.Database.PerWorkspace || cfg.Database.WorkspaceCollection != "tenants" {
+		t.Errorf("Workspace database settings not applied, got %+v", cfg.Database)
+	}
+
+	t.Setenv("DB_PER_WORKSPACE", "sometimes")
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), "DB_PER_WORKSPACE") {
+		t.Errorf("Expected error mentioning DB_PER_WORKSPACE, got %v instead", err)
+	}
+}
+
+/*
+ * function: Test_Load_should_apply_rate_limits_from_environment
+ * --------------------
+ * Tests if Load applies per-group rate limits from environment variables and rejects non-positive limits unless
+ * rate limiting is disabled.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Load_should_apply_rate_limits_from_environment(t *testing.T) {
+	t.Setenv("DB_URL", "mongodb://localhost:27017")
+	t.Setenv("RATE_LIMIT_WRITE_PER_MINUTE", "10")
+	t.Setenv("RATE_LIMIT_WRITE_BURST", "2")
+
+	cfg, err := Load()
+
+	if err != nil {
+		t.Fatalf("Expected nil, got error %v instead", err)
+	}
+	if cfg.RateLimit.Write != (Limit{PerMinute: 10, Burst: 2}) || cfg.RateLimit.Read != Default().RateLimit.Read {
+		t.Errorf("Rate limits not applied, got %+v", cfg.RateLimit)
+	}
+
+	t.Setenv("RATE_LIMIT_PUBLIC_BURST", "0")
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), "rate limits") {
+		t.Errorf("Expected error mentioning rate limits, got %v instead", err)
+	}
+
+	t.Setenv("RATE_LIMIT_ENABLED", "false")
+	if _, err := Load(); err != nil {
+		t.Errorf("Expected nil for disabled rate limiting, got error %v instead", err)
+	}
+}
+
+/*
+ * function: Test_Load_should_apply_cors_settings_from_environment
+ * --------------------
+ * Tests if Load splits the CORS lists from environment variables and rejects credentials for any origin.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Load_should_apply_cors_settings_from_environment(t *testing.T) {
+	t.Setenv("DB_URL", "mongodb://localhost:27017")
+	t.Setenv("CORS_ALLOWED_ORIGINS", "https://app.example.com, https://admin.example.com")
+	t.Setenv("CORS_ALLOW_CREDENTIALS", "true")
+	t.Setenv("CORS_MAX_AGE", "1h")
+
+	cfg, err := Load()
+
+	if err != nil {
+		t.Fatalf("Expected nil, got error %v instead", err)
+	}
+	if len(cfg.CORS.AllowedOrigins) != 2 || cfg.CORS.AllowedOrigins[1] != "https://admin.example.com" {
+		t.Errorf("Expected two trimmed origins, got %q", cfg.CORS.AllowedOrigins)
+	}
+	if !cfg.CORS.AllowCredentials || cfg.CORS.MaxAge.Duration != time.Hour || len(cfg.CORS.AllowedMethods) != 4 {
+		t.Errorf("CORS settings not applied, got %+v", cfg.CORS)
+	}
+
+	t.Setenv("CORS_ALLOWED_ORIGINS", "*")
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), "cors credentials") {
+		t.Errorf("Expected error mentioning cors credentials, got %v instead", err)
+	}
+}
+
+/*
+ * function: Test_Load_should_apply_trusted_proxies_from_environment
+ * --------------------
+ * Tests if Load splits the trusted proxies from the environment variable and rejects entries that are neither IP
+ * addresses nor CIDR networks.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Load_should_apply_trusted_proxies_from_environment(t *testing.T) {
+	t.Setenv("DB_URL", "mongodb://localhost:27017")
+	t.Setenv("SERVER_TRUSTED_PROXIES", "10.0.0.0/8, 192.0.2.1")
+
+	cfg, err := Load()
+
+	if err != nil {
+		t.Fatalf("Expected nil, got error %v instead", err)
+	}
+	networks, err := cfg.Server.TrustedNetworks()
+	if err != nil || len(networks) != 2 || networks[1].String() != "192.0.2.1/32" {
+		t.Errorf("Expected two trusted networks, got %v and %v", networks, err)
+	}
+
+	t.Setenv("SERVER_TRUSTED_PROXIES", "10.0.0.0/33")
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), "trusted proxy") {
+		t.Errorf("Expected error mentioning trusted proxy, got %v instead", err)
+	}
+}
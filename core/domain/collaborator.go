@@ -0,0 +1,73 @@
+/*
+ * package: domain
+ * --------------------
+ * Includes definitions of types representing the domain model.
+ * Also includes methods defined upon these types.
+ */
+
+package domain
+
+import "github.com/luschnat-ziegler/toDoListAPI/errs"
+
+type Role string
+
+const (
+	RoleViewer Role = "viewer"
+	RoleEditor Role = "editor"
+	RoleOwner  Role = "owner"
+)
+
+var roleRanks = map[Role]int{
+	RoleViewer: 1,
+	RoleEditor: 2,
+	RoleOwner:  3,
+}
+
+type Collaborator struct {
+	Subject string `json:"subject" bson:"subject" validate:"required"`
+	Role    Role   `json:"role" bson:"role" validate:"required,oneof=viewer editor owner"`
+}
+
+/*
+ * Method: Role.IsValid
+ * --------------------
+ * Checks whether the role is one of the known roles (viewer, editor or owner).
+ *
+ * returns: true if the role is known, false otherwise
+ */
+
+func (role Role) IsValid() bool {
+	_, ok := roleRanks[role]
+	return ok
+}
+
+/*
+ * Method: Role.Includes
+ * --------------------
+ * Checks whether the role grants at least the permissions of another role. Roles are ordered
+ * viewer < editor < owner; unknown roles include nothing.
+ *
+ * required: the Role whose permissions are required
+ *
+ * returns: true if the role grants the permissions of the required role, false otherwise
+ */
+
+func (role Role) Includes(required Role) bool {
+	rank, ok := roleRanks[role]
+	return ok && rank >= roleRanks[required]
+}
+
+/*
+ * Method: Collaborator.Validate
+ * --------------------
+ * Validates the Collaborator using github.com/go-playground/validator/v10
+ * Rules are defined in the tags provided in the Collaborator type definition.
+ *
+ * returns: a pointer to an errs.ValidationError with invalid fields
+ *          and their respective violations in case of failed validation.
+ *          Otherwise, on successful validation, nil is returned.
+ */
+
+func (collaborator Collaborator) Validate() *errs.ValidationError {
+	return validateStruct(collaborator)
+}
@@ -0,0 +1,61 @@
+/*
+ * package: domain
+ * --------------------
+ * Includes definitions of types representing the domain model.
+ * Also includes methods defined upon these types.
+ */
+
+package domain
+
+import (
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const ListIdPattern = "[0-9a-fA-F]{24}"
+
+type ListId primitive.ObjectID
+
+/*
+ * Function: ParseListId
+ * --------------------
+ * Parses the string representation of a list id (24 hexadecimal digits, see ListIdPattern). To be called wherever
+ * an id enters the application, so that malformed ids are rejected uniformly before any database access.
+ *
+ * id: the string representation of the id
+ *
+ * returns: the ListId and nil on success.
+ *          Otherwise, the zero value and a pointer to an errs.AppError with code 400 are returned.
+ */
+
+func ParseListId(id string) (ListId, *errs.AppError) {
+	objectId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ListId{}, errs.Localized(errs.NewBadRequestError, errs.MessageInvalidId)
+	}
+	return ListId(objectId), nil
+}
+
+/*
+ * Method: ListId.ObjectID
+ * --------------------
+ * Converts the ListId into the primitive.ObjectID lists are stored with.
+ *
+ * returns: a primitive.ObjectID
+ */
+
+func (id ListId) ObjectID() primitive.ObjectID {
+	return primitive.ObjectID(id)
+}
+
+/*
+ * Method: ListId.String
+ * --------------------
+ * Formats the ListId as 24 hexadecimal digits.
+ *
+ * returns: the string representation of the id
+ */
+
+func (id ListId) String() string {
+	return primitive.ObjectID(id).Hex()
+}
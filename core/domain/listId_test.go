@@ -0,0 +1,42 @@
+/*
+ * Package: domain_test
+ * --------------------
+ * Includes test of domain model type methods.
+ * Note: Excluded from package domain in order to prevent circular imports.
+ */
+
+package domain_test
+
+import (
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"net/http"
+	"regexp"
+	"testing"
+)
+
+/*
+ * Function: Test_ParseListId_should_accept_hex_object_ids_only
+ * --------------------
+ * Tests functionality of ParseListId by checking that ids of 24 hexadecimal digits are parsed and formatted in
+ * lowercase, while other ids are rejected with code 400, in line with ListIdPattern.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ */
+
+func Test_ParseListId_should_accept_hex_object_ids_only(t *testing.T) {
+	pattern := regexp.MustCompile("^" + domain.ListIdPattern + "$")
+
+	id, err := domain.ParseListId("601BE448B9B5E15374B1E842")
+	if err != nil || id.String() != "601be448b9b5e15374b1e842" || id.ObjectID().Hex() != id.String() {
+		t.Errorf("Expected parsed id 601be448b9b5e15374b1e842, got %v and %v instead", id, err)
+	}
+
+	for _, malformed := range []string{"", "test_id", "601be448b9b5e15374b1e84", "601be448b9b5e15374b1e842a", "601be448b9b5e15374b1e84g"} {
+		if _, err := domain.ParseListId(malformed); err == nil || err.Code != http.StatusBadRequest {
+			t.Errorf("Expected code 400 for id %q, got %v instead", malformed, err)
+		}
+		if pattern.MatchString(malformed) {
+			t.Errorf("Expected ListIdPattern not to match %q", malformed)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+/*
+ * package: domain
+ * --------------------
+ * Includes definitions of types representing the domain model.
+ * Also includes methods defined upon these types.
+ */
+
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"github.com/google/uuid"
+	"time"
+)
+
+type ShareLink struct {
+	Id          string     `json:"id" bson:"_id"`
+	Token       string     `json:"token,omitempty" bson:"-"`
+	TokenHash   string     `json:"-" bson:"tokenHash"`
+	ListId      string     `json:"listId" bson:"listId"`
+	WorkspaceId string     `json:"-" bson:"workspaceId,omitempty"`
+	CreatedBy   string     `json:"createdBy,omitempty" bson:"createdBy,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt" bson:"createdAt"`
+	ExpiresAt   *time.Time `json:"expiresAt" bson:"expiresAt"`
+}
+
+/*
+ * Function: NewShareLink
+ * --------------------
+ * Instantiates a ShareLink with a new id and a new unguessable token (256 random bits, base64url encoded). Only
+ * the hash of the token (see HashShareToken) is meant to be persisted; the token itself is returned to the creator
+ * once.
+ *
+ * listId: the id of the shared list
+ * createdBy: the subject of the principal creating the link
+ * expiresAt: the time the link expires at, or nil for links that do not expire
+ *
+ * returns: the ShareLink and nil, or the zero value and an error if no random token could be generated
+ */
+
+func NewShareLink(listId string, createdBy string, expiresAt *time.Time) (ShareLink, error) {
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return ShareLink{}, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(random)
+
+	return ShareLink{
+		Id:        uuid.NewString(),
+		Token:     token,
+		TokenHash: HashShareToken(token),
+		ListId:    listId,
+		CreatedBy: createdBy,
+		CreatedAt: time.Now().UTC(),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+/*
+ * Function: HashShareToken
+ * --------------------
+ * Hashes a share link token for storage and lookup, so that stored links cannot be used to access lists.
+ *
+ * token: the token of a ShareLink
+ *
+ * returns: the hex encoded SHA-256 hash of the token
+ */
+
+func HashShareToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+/*
+ * Method: ShareLink.IsActive
+ * --------------------
+ * Checks whether the link has not expired yet.
+ *
+ * now: the current time
+ *
+ * returns: true if the link does not expire or expires after now, false otherwise
+ */
+
+func (shareLink ShareLink) IsActive(now time.Time) bool {
+	return shareLink.ExpiresAt == nil || shareLink.ExpiresAt.After(now)
+}
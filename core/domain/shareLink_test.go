@@ -0,0 +1,57 @@
+/*
+ * Package: domain_test
+ * --------------------
+ * Includes test of domain model type methods.
+ * Note: Excluded from package domain in order to prevent circular imports.
+ */
+
+package domain_test
+
+import (
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"testing"
+	"time"
+)
+
+/*
+ * Function: Test_NewShareLink_should_create_unique_tokens_with_matching_hash
+ * --------------------
+ * Tests functionality of NewShareLink by checking that tokens differ between links and match the stored hash.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ */
+
+func Test_NewShareLink_should_create_unique_tokens_with_matching_hash(t *testing.T) {
+	first, err := domain.NewShareLink("list_id", "alice", nil)
+	if err != nil {
+		t.Fatalf("Expected nil, got error %v instead", err)
+	}
+	second, _ := domain.NewShareLink("list_id", "alice", nil)
+
+	if first.Token == second.Token || first.Id == second.Id {
+		t.Error("Expected distinct ids and tokens")
+	}
+	if first.TokenHash != domain.HashShareToken(first.Token) || first.TokenHash == first.Token {
+		t.Errorf("Expected hash of token, got %q instead", first.TokenHash)
+	}
+}
+
+/*
+ * Function: Test_ShareLink_IsActive_should_respect_expiry
+ * --------------------
+ * Tests functionality of ShareLink.IsActive for links without expiry, expired links and links expiring later.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ */
+
+func Test_ShareLink_IsActive_should_respect_expiry(t *testing.T) {
+	now := time.Now()
+	past, future := now.Add(-time.Minute), now.Add(time.Minute)
+
+	if !(domain.ShareLink{}).IsActive(now) || !(domain.ShareLink{ExpiresAt: &future}).IsActive(now) {
+		t.Error("Expected links without expiry or with future expiry to be active")
+	}
+	if (domain.ShareLink{ExpiresAt: &past}).IsActive(now) {
+		t.Error("Expected expired link to be inactive")
+	}
+}
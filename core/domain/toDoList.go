@@ -8,25 +8,28 @@
 package domain
 
 import (
-	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"github.com/luschnat-ziegler/toDoListAPI/errs"
 	"go.mongodb.org/mongo-driver/bson/primitive"
-	"reflect"
-	"strings"
+	"time"
 )
 
 type ToDoList struct {
-	Id          primitive.ObjectID `json:"id,omitempty" bson:"_id,omitempty"`
-	Name        string             `json:"name,omitempty" bson:"name,omitempty" validate:"required"`
-	Description *string            `json:"description" bson:"description"`
-	Tasks       []Task             `json:"tasks,omitempty" bson:"tasks,omitempty" validate:"required,dive,required"`
+	Id            primitive.ObjectID `json:"id,omitempty" bson:"_id,omitempty"`
+	WorkspaceId   string             `json:"workspaceId,omitempty" bson:"workspaceId,omitempty"`
+	OwnerId       string             `json:"ownerId,omitempty" bson:"ownerId,omitempty"`
+	Collaborators []Collaborator     `json:"collaborators,omitempty" bson:"collaborators,omitempty"`
+	Name          string             `json:"name,omitempty" bson:"name,omitempty" validate:"required,max=200"`
+	Description   *string            `json:"description" bson:"description" validate:"description"`
+	Tasks         []Task             `json:"tasks,omitempty" bson:"tasks,omitempty" validate:"required,max=1000,unique_task_names,dive,required"`
 }
 
 type Task struct {
-	Id          string  `json:"id" bson:"id"`
-	Name        string  `json:"name,omitempty" bson:"name,omitempty" validate:"required"`
-	Description *string `json:"description" bson:"description"`
+	Id          string     `json:"id" bson:"id"`
+	Name        string     `json:"name,omitempty" bson:"name,omitempty" validate:"required,max=200"`
+	Description *string    `json:"description" bson:"description" validate:"description"`
+	Done        bool       `json:"done" bson:"done"`
+	Due         *time.Time `json:"due" bson:"due"`
 }
 
 /*
@@ -43,6 +46,24 @@ func (toDoList *ToDoList) AssignTaskIDs() {
 	}
 }
 
+/*
+ * Method: toDoList.AssignMissingTaskIDs
+ * --------------------
+ * Assigns new, unique ids (uuid) to every Task in the ToDoList without an id. Ids already used by a previous Task
+ * of the list are replaced as well, all other existing Task ids are kept.
+ * Modifies the ToDoList it is applied to (pointer receiver)
+ */
+
+func (toDoList *ToDoList) AssignMissingTaskIDs() {
+	seen := make(map[string]bool, len(toDoList.Tasks))
+	for i := range toDoList.Tasks {
+		if id := toDoList.Tasks[i].Id; id == "" || seen[id] {
+			toDoList.Tasks[i].Id = uuid.NewString()
+		}
+		seen[toDoList.Tasks[i].Id] = true
+	}
+}
+
 /*
  * Method: toDoList.ResetID
  * --------------------
@@ -57,11 +78,35 @@ func (toDoList *ToDoList) ResetID() {
 	toDoList.Id = primitive.ObjectID{}
 }
 
+/*
+ * Method: toDoList.RoleOf
+ * --------------------
+ * Determines the role of a subject on the ToDoList. The owner always has the owner role, collaborators have
+ * the role they were granted.
+ *
+ * subject: the subject of a principal (see auth.Principal)
+ *
+ * returns: the Role of the subject and true, or the zero value and false if the subject has no access
+ */
+
+func (toDoList ToDoList) RoleOf(subject string) (Role, bool) {
+	if toDoList.OwnerId == subject {
+		return RoleOwner, true
+	}
+	for _, collaborator := range toDoList.Collaborators {
+		if collaborator.Subject == subject {
+			return collaborator.Role, true
+		}
+	}
+	return "", false
+}
+
 /*
  * Method: toDoList.Validate
  * --------------------
  * Validates the ToDoList using github.com/go-playground/validator/v10
- * Rules are defined in the tags provided in the ToDoList type definition.
+ * Rules are defined in the tags provided in the ToDoList type definition, including the custom rules registered in
+ * newValidator (task names must be unique within a list).
  *
  * returns: a pointer to an errs.ValidationError with invalid fields
  *          and their respective violations in case of failed validation.
@@ -69,26 +114,5 @@ func (toDoList *ToDoList) ResetID() {
  */
 
 func (toDoList ToDoList) Validate() *errs.ValidationError {
-	v := validator.New()
-
-	v.RegisterTagNameFunc(func(fld reflect.StructField) string {
-		name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
-		if name == "-" {
-			return ""
-		}
-		return name
-	})
-
-	err := v.Struct(toDoList)
-
-	if err != nil {
-		var invalidFields = make(map[string]string)
-		for _, e := range err.(validator.ValidationErrors) {
-			fieldName := strings.SplitAfterN(e.Namespace(), ".", 2)[1]
-			invalidFields[fieldName] = e.Tag()
-		}
-		return errs.NewValidationError(invalidFields)
-	} else {
-		return nil
-	}
+	return validateStruct(toDoList)
 }
@@ -8,10 +8,14 @@
 package domain_test
 
 import (
+	"fmt"
 	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
 	"github.com/luschnat-ziegler/toDoListAPI/testUtils/dummies"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"net/http"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -138,6 +142,38 @@ func Test_ToDoList_AssignTaskIDs_should_set_or_reset_all_task_ids_to_new_values(
 	}
 }
 
+/*
+ * Function: Test_ToDoList_AssignMissingTaskIDs_should_keep_existing_and_assign_missing_or_duplicate_ids
+ * --------------------
+ * Tests functionality of ToDoList.AssignMissingTaskIDs by checking if existing ids are kept while empty ids and ids
+ * already used by a previous task are replaced by new values.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ */
+
+func Test_ToDoList_AssignMissingTaskIDs_should_keep_existing_and_assign_missing_or_duplicate_ids(t *testing.T) {
+	dummyList := domain.ToDoList{
+		Name: "Dummy List Name",
+		Tasks: []domain.Task{
+			{Id: "1234", Name: "Dummy Task 1"},
+			{Id: "", Name: "Dummy Task 2"},
+			{Id: "1234", Name: "Dummy Task 3"},
+		},
+	}
+
+	dummyList.AssignMissingTaskIDs()
+
+	if dummyList.Tasks[0].Id != "1234" {
+		t.Errorf("Expected id 1234 to be kept, got %v instead", dummyList.Tasks[0].Id)
+	}
+	if dummyList.Tasks[1].Id == "" {
+		t.Error("Expected new uuid string, got zero value instead")
+	}
+	if dummyList.Tasks[2].Id == "1234" || dummyList.Tasks[2].Id == dummyList.Tasks[1].Id {
+		t.Errorf("Expected new uuid string for duplicate id, got %v instead", dummyList.Tasks[2].Id)
+	}
+}
+
 /*
  * Function: Test_ToDoList_Validate_should_return_nil_if_provided_with_valid_list
  * --------------------
@@ -185,3 +221,149 @@ func Test_ToDoList_Validate_should_return_matching_validation_error_with_invalid
 		t.Errorf(`InvalidFields map has wrong value associated with key "name". Expected "required", got %v instead.`, value)
 	}
 }
+
+/*
+ * Function: Test_ToDoList_Validate_should_cap_task_count_and_string_lengths
+ * --------------------
+ * Tests functionality of ToDoList.Validate by checking that overlong names and descriptions of lists and tasks as
+ * well as too many tasks are reported.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ */
+
+func Test_ToDoList_Validate_should_cap_task_count_and_string_lengths(t *testing.T) {
+	longDescription := strings.Repeat("x", 2001)
+	list := domain.ToDoList{
+		Name:        strings.Repeat("x", 201),
+		Description: &longDescription,
+		Tasks:       []domain.Task{{Name: strings.Repeat("x", 201), Description: &longDescription}},
+	}
+
+	err := list.Validate()
+	if err == nil {
+		t.Fatal("Expected validation error, got nil instead")
+	}
+	for _, field := range []string{"name", "description", "tasks[0].name", "tasks[0].description"} {
+		if value := err.InvalidFields[field]; value != "max" {
+			t.Errorf(`Expected "max" for field %s, got %q instead`, field, value)
+		}
+	}
+
+	list = domain.ToDoList{Name: "many tasks", Tasks: make([]domain.Task, 1001)}
+	for i := range list.Tasks {
+		list.Tasks[i].Name = "task"
+	}
+	if err := list.Validate(); err == nil || err.InvalidFields["tasks"] != "max" {
+		t.Errorf(`Expected "max" for field tasks, got %v instead`, err)
+	}
+}
+
+/*
+ * Function: Test_ToDoList_Validate_should_describe_violations_with_rule_param_value_and_message
+ * --------------------
+ * Tests functionality of ToDoList.Validate by checking that every violation names its rule and parameter, echoes
+ * short rejected values only and carries a message.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ */
+
+func Test_ToDoList_Validate_should_describe_violations_with_rule_param_value_and_message(t *testing.T) {
+	longDescription := strings.Repeat("x", 2001)
+	list := domain.ToDoList{
+		Name:  "",
+		Tasks: []domain.Task{{Name: "task", Description: &longDescription}},
+	}
+
+	err := list.Validate()
+	if err == nil {
+		t.Fatal("Expected validation error, got nil instead")
+	}
+
+	expected := []errs.FieldViolation{
+		errs.NewFieldViolation("name", "required", "", nil, false),
+		errs.NewFieldViolation("tasks[0].description", "max", "2000", nil, false),
+	}
+	if !reflect.DeepEqual(err.Violations, expected) {
+		t.Errorf("Expected violations %+v, got %+v instead", expected, err.Violations)
+	}
+	if expected[1].Message != "must be at most 2000 characters long" {
+		t.Errorf("Expected message naming the limit, got %q instead", expected[1].Message)
+	}
+
+	list = domain.ToDoList{Name: "many tasks", Tasks: make([]domain.Task, 1001)}
+	for i := range list.Tasks {
+		list.Tasks[i].Name = fmt.Sprintf("task %d", i)
+	}
+	err = list.Validate()
+	if err == nil || len(err.Violations) != 1 || err.Violations[0].Message != "must contain at most 1000 items" {
+		t.Errorf("Expected violation of max items, got %+v instead", err)
+	}
+}
+
+/*
+ * Function: Test_ToDoList_Validate_should_require_unique_task_names
+ * --------------------
+ * Tests functionality of ToDoList.Validate by checking that task names differing in case or surrounding whitespace
+ * only are reported, while distinct names are not.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ */
+
+func Test_ToDoList_Validate_should_require_unique_task_names(t *testing.T) {
+	list := domain.ToDoList{Name: "list", Tasks: []domain.Task{{Name: "Buy milk"}, {Name: " buy MILK "}}}
+
+	err := list.Validate()
+	if err == nil || err.InvalidFields["tasks"] != "unique_task_names" {
+		t.Errorf(`Expected "unique_task_names" for field tasks, got %v instead`, err)
+	}
+
+	list.Tasks[1].Name = "Buy bread"
+	if err := list.Validate(); err != nil {
+		t.Errorf("Expected nil, got validation error %v instead", err.InvalidFields)
+	}
+}
+
+/*
+ * Function: Test_ToDoList_RoleOf_should_return_roles_of_owner_and_collaborators
+ * --------------------
+ * Tests functionality of ToDoList.RoleOf by checking the roles of the owner, a collaborator and a stranger.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ */
+
+func Test_ToDoList_RoleOf_should_return_roles_of_owner_and_collaborators(t *testing.T) {
+	list := domain.ToDoList{
+		OwnerId:       "alice",
+		Collaborators: []domain.Collaborator{{Subject: "bob", Role: domain.RoleViewer}},
+	}
+
+	if role, ok := list.RoleOf("alice"); !ok || role != domain.RoleOwner {
+		t.Errorf("Expected owner role for owner, got %q (%v) instead", role, ok)
+	}
+	if role, ok := list.RoleOf("bob"); !ok || role != domain.RoleViewer {
+		t.Errorf("Expected viewer role for collaborator, got %q (%v) instead", role, ok)
+	}
+	if _, ok := list.RoleOf("mallory"); ok {
+		t.Error("Expected no role for stranger")
+	}
+}
+
+/*
+ * Function: Test_Role_Includes_should_order_roles
+ * --------------------
+ * Tests functionality of Role.Includes by checking the order viewer < editor < owner and unknown roles.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ */
+
+func Test_Role_Includes_should_order_roles(t *testing.T) {
+	if !domain.RoleOwner.Includes(domain.RoleEditor) || !domain.RoleEditor.Includes(domain.RoleEditor) {
+		t.Error("Expected owner and editor to include editor")
+	}
+	if domain.RoleViewer.Includes(domain.RoleEditor) || domain.RoleEditor.Includes(domain.RoleOwner) {
+		t.Error("Expected viewer not to include editor and editor not to include owner")
+	}
+	if domain.Role("admin").Includes(domain.RoleViewer) || domain.Role("admin").IsValid() {
+		t.Error("Expected unknown role to be invalid and include nothing")
+	}
+}
@@ -0,0 +1,144 @@
+/*
+ * package: domain
+ * --------------------
+ * Includes definitions of types representing the domain model.
+ * Also includes methods defined upon these types.
+ */
+
+package domain
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxEchoedLength = 100
+
+var validate = newValidator()
+
+/*
+ * Function: newValidator
+ * --------------------
+ * Instantiates the validator shared by all types of the domain model. Invalid fields are named after their json
+ * tags. Besides the built-in rules, the following are registered:
+ *   - description: an optional text of at most 2000 characters (alias of "omitempty,max=2000")
+ *   - workspace_id: an id of a workspace, usable in database names (alias of "required,max=32,lowercase,alphanum")
+ *   - unique_task_names: no two tasks of a list share a name (compared case-insensitively, ignoring surrounding
+ *     whitespace)
+ *
+ * returns: a pointer to a validator.Validate
+ */
+
+func newValidator() *validator.Validate {
+	v := validator.New()
+
+	v.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+
+	v.RegisterAlias("description", "omitempty,max=2000")
+	v.RegisterAlias("workspace_id", "required,max=32,lowercase,alphanum")
+	if err := v.RegisterValidation("unique_task_names", uniqueTaskNames); err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+/*
+ * Function: uniqueTaskNames
+ * --------------------
+ * Custom validation rule checking that no two tasks in a slice of Task share a name. Names are compared
+ * case-insensitively, ignoring surrounding whitespace. Empty names are left to the required rule.
+ *
+ * fl: the validator.FieldLevel of the field to be validated
+ *
+ * returns: true if the names are unique, false otherwise
+ */
+
+func uniqueTaskNames(fl validator.FieldLevel) bool {
+	tasks, ok := fl.Field().Interface().([]Task)
+	if !ok {
+		return false
+	}
+
+	seen := make(map[string]bool, len(tasks))
+	for _, task := range tasks {
+		name := strings.ToLower(strings.TrimSpace(task.Name))
+		if name == "" {
+			continue
+		}
+		if seen[name] {
+			return false
+		}
+		seen[name] = true
+	}
+	return true
+}
+
+/*
+ * Function: validateStruct
+ * --------------------
+ * Validates a struct of the domain model using the shared validator (see newValidator). Every invalid field is
+ * reported with the rule it failed, the parameter of the rule and, if safe to be echoed, the rejected value.
+ *
+ * value: the struct to be validated
+ *
+ * returns: a pointer to an errs.ValidationError with invalid fields
+ *          and their respective violations in case of failed validation.
+ *          Otherwise, on successful validation, nil is returned.
+ */
+
+func validateStruct(value interface{}) *errs.ValidationError {
+	err := validate.Struct(value)
+	if err == nil {
+		return nil
+	}
+
+	validationErrors := err.(validator.ValidationErrors)
+	violations := make([]errs.FieldViolation, 0, len(validationErrors))
+	for _, e := range validationErrors {
+		fieldName := strings.SplitAfterN(e.Namespace(), ".", 2)[1]
+		collection := e.Kind() == reflect.Slice || e.Kind() == reflect.Map
+		violations = append(violations, errs.NewFieldViolation(fieldName, e.ActualTag(), e.Param(), safeValue(e.Value()), collection))
+	}
+	return errs.NewValidationError(violations)
+}
+
+/*
+ * Function: safeValue
+ * --------------------
+ * Determines whether a rejected value may be echoed in a validation error. Only non-zero scalar values are, strings
+ * only if they are no longer than maxEchoedLength characters.
+ *
+ * value: the rejected value
+ *
+ * returns: the value, or nil if it is not to be echoed
+ */
+
+func safeValue(value interface{}) interface{} {
+	v := reflect.ValueOf(value)
+	if !v.IsValid() || v.IsZero() {
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		if utf8.RuneCountInString(v.String()) > maxEchoedLength {
+			return nil
+		}
+		return v.String()
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return value
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,70 @@
+/*
+ * package: domain
+ * --------------------
+ * Includes definitions of types representing the domain model.
+ * Also includes methods defined upon these types.
+ */
+
+package domain
+
+import "github.com/luschnat-ziegler/toDoListAPI/errs"
+
+type Workspace struct {
+	Id      string   `json:"id" bson:"_id" validate:"workspace_id"`
+	OwnerId string   `json:"ownerId,omitempty" bson:"ownerId,omitempty"`
+	Name    string   `json:"name" bson:"name" validate:"required"`
+	Members []string `json:"members" bson:"members" validate:"dive,required"`
+}
+
+/*
+ * Method: Workspace.IsMember
+ * --------------------
+ * Checks whether a subject belongs to the Workspace, either as its owner or as one of its members.
+ *
+ * subject: the subject of a principal (see auth.Principal)
+ *
+ * returns: true if the subject belongs to the workspace, false otherwise
+ */
+
+func (workspace Workspace) IsMember(subject string) bool {
+	if workspace.OwnerId == subject {
+		return true
+	}
+	for _, member := range workspace.Members {
+		if member == subject {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+ * Function: IsValidWorkspaceId
+ * --------------------
+ * Checks an id of a workspace against the rules for the Id of a Workspace, e.g. before trusting a workspace claimed
+ * by a token that has not been looked up.
+ *
+ * id: the id of the workspace
+ *
+ * returns: true if the id is valid, false otherwise
+ */
+
+func IsValidWorkspaceId(id string) bool {
+	return validate.Var(id, "workspace_id") == nil
+}
+
+/*
+ * Method: Workspace.Validate
+ * --------------------
+ * Validates the Workspace using github.com/go-playground/validator/v10
+ * Rules are defined in the tags provided in the Workspace type definition. Ids are restricted to lowercase
+ * letters and digits as they are used in database names (see config.Database.PerWorkspace).
+ *
+ * returns: a pointer to an errs.ValidationError with invalid fields
+ *          and their respective violations in case of failed validation.
+ *          Otherwise, on successful validation, nil is returned.
+ */
+
+func (workspace Workspace) Validate() *errs.ValidationError {
+	return validateStruct(workspace)
+}
@@ -0,0 +1,81 @@
+/*
+ * Package: domain_test
+ * --------------------
+ * Includes test of domain model type methods.
+ * Note: Excluded from package domain in order to prevent circular imports.
+ */
+
+package domain_test
+
+import (
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"testing"
+)
+
+/*
+ * Function: Test_Workspace_IsMember_should_include_owner_and_members
+ * --------------------
+ * Tests functionality of Workspace.IsMember by checking the owner, a member and a stranger.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ */
+
+func Test_Workspace_IsMember_should_include_owner_and_members(t *testing.T) {
+	workspace := domain.Workspace{Id: "teama", OwnerId: "alice", Name: "Team A", Members: []string{"bob"}}
+
+	if !workspace.IsMember("alice") || !workspace.IsMember("bob") {
+		t.Error("Expected owner and member to belong to the workspace")
+	}
+	if workspace.IsMember("mallory") {
+		t.Error("Expected stranger not to belong to the workspace")
+	}
+}
+
+/*
+ * Function: Test_Workspace_Validate_should_restrict_ids
+ * --------------------
+ * Tests functionality of Workspace.Validate by checking that ids are restricted to lowercase letters and digits
+ * and that rejected ids are echoed.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ */
+
+func Test_Workspace_Validate_should_restrict_ids(t *testing.T) {
+	if err := (domain.Workspace{Id: "team1", Name: "Team 1"}).Validate(); err != nil {
+		t.Errorf("Expected nil, got validation error %v instead", err.InvalidFields)
+	}
+
+	for _, id := range []string{"", "Team1", "team.1", "team_1"} {
+		err := domain.Workspace{Id: id, Name: "Team 1"}.Validate()
+		if err == nil {
+			t.Errorf("Expected validation error for id %q, got nil instead", id)
+		} else if _, ok := err.InvalidFields["id"]; !ok {
+			t.Errorf("Expected invalid field id for id %q, got %v instead", id, err.InvalidFields)
+		}
+	}
+
+	err := domain.Workspace{Id: "Team1", Name: "Team 1"}.Validate()
+	if err == nil || len(err.Violations) != 1 || err.Violations[0].Value != "Team1" {
+		t.Errorf("Expected rejected id to be echoed, got %+v instead", err)
+	}
+}
+
+/*
+ * Function: Test_IsValidWorkspaceId_should_apply_rules_of_Workspace_Id
+ * --------------------
+ * Tests functionality of IsValidWorkspaceId by checking ids accepted and rejected by Workspace.Validate, including
+ * ids that would change the database name they are appended to (see config.Database.PerWorkspace).
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ */
+
+func Test_IsValidWorkspaceId_should_apply_rules_of_Workspace_Id(t *testing.T) {
+	if !domain.IsValidWorkspaceId("team1") {
+		t.Error("Expected team1 to be valid")
+	}
+	for _, id := range []string{"", "Team1", "team.1", "team/1", "a23456789012345678901234567890123"} {
+		if domain.IsValidWorkspaceId(id) {
+			t.Errorf("Expected %q to be invalid", id)
+		}
+	}
+}
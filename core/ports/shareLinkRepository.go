@@ -0,0 +1,21 @@
+/*
+ * package: ports
+ * --------------------
+ * Includes interface definitions for layer connections (services and repositories).
+ */
+
+package ports
+
+import (
+	"context"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+)
+
+//go:generate mockgen -destination=../../mocks/ports/mockShareLinkRepository.go -package=ports github.com/luschnat-ziegler/toDoListAPI/core/ports ShareLinkRepository
+type ShareLinkRepository interface {
+	Save(context.Context, domain.ShareLink) (*domain.ShareLink, *errs.AppError)
+	GetAllByListId(context.Context, string) (*[]domain.ShareLink, *errs.AppError)
+	GetOneByTokenHash(context.Context, string) (*domain.ShareLink, *errs.AppError)
+	DeleteOneById(context.Context, string, string) *errs.AppError
+}
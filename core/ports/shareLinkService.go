@@ -0,0 +1,22 @@
+/*
+ * package: ports
+ * --------------------
+ * Includes interface definitions for layer connections (services and repositories).
+ */
+
+package ports
+
+import (
+	"context"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"time"
+)
+
+//go:generate mockgen -destination=../../mocks/ports/mockShareLinkService.go -package=ports github.com/luschnat-ziegler/toDoListAPI/core/ports ShareLinkService
+type ShareLinkService interface {
+	CreateShareLink(context.Context, string, *time.Time) (*domain.ShareLink, *errs.AppError)
+	GetShareLinks(context.Context, string) (*[]domain.ShareLink, *errs.AppError)
+	RevokeShareLink(context.Context, string, string) *errs.AppError
+	GetSharedList(context.Context, string) (*domain.ToDoList, *errs.AppError)
+}
@@ -7,15 +7,21 @@
 package ports
 
 import (
+	"context"
 	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
 	"github.com/luschnat-ziegler/toDoListAPI/errs"
 )
 
 //go:generate mockgen -destination=../../mocks/ports/mockToDoListRepository.go -package=ports github.com/luschnat-ziegler/toDoListAPI/core/ports ToDoListRepository
 type ToDoListRepository interface {
-	GetAll() (*[]domain.ToDoList, *errs.AppError)
-	GetOneById(string) (*domain.ToDoList, *errs.AppError)
-	UpdateOneById(string, domain.ToDoList) (*domain.ToDoList, *errs.AppError)
-	Save(domain.ToDoList) (*domain.ToDoList, *errs.AppError)
-	DeleteOneById(string) *errs.AppError
+	GetAll(context.Context, string) (*[]domain.ToDoList, *errs.AppError)
+	GetOneById(context.Context, string, domain.ListId) (*domain.ToDoList, *errs.AppError)
+	GetOneSharedById(context.Context, domain.ListId) (*domain.ToDoList, *errs.AppError)
+	UpdateOneById(context.Context, string, domain.ListId, domain.ToDoList) (*domain.ToDoList, *errs.AppError)
+	Save(context.Context, domain.ToDoList) (*domain.ToDoList, *errs.AppError)
+	DeleteOneById(context.Context, string, domain.ListId) *errs.AppError
+	UpdateCollaborators(context.Context, string, domain.ListId, []domain.Collaborator) (*domain.ToDoList, *errs.AppError)
+	ForEach(context.Context, func(domain.ToDoList) error) *errs.AppError
+	Restore(context.Context, domain.ToDoList, bool) (bool, *errs.AppError)
+	Ping(context.Context) *errs.AppError
 }
@@ -7,15 +7,18 @@
 package ports
 
 import (
+	"context"
 	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
 	"github.com/luschnat-ziegler/toDoListAPI/errs"
 )
 
 //go:generate mockgen -destination=../../mocks/ports/mockToDoListService.go -package=ports github.com/luschnat-ziegler/toDoListAPI/core/ports ToDoListService
 type ToDoListService interface {
-	GetAllLists() (*[]domain.ToDoList, *errs.AppError)
-	SaveList(domain.ToDoList) (*domain.ToDoList, *errs.AppError)
-	GetOneListById(string) (*domain.ToDoList, *errs.AppError)
-	UpdateOneListById(string, domain.ToDoList) (*domain.ToDoList, *errs.AppError)
-	DeleteListById(string) *errs.AppError
+	GetAllLists(context.Context) (*[]domain.ToDoList, *errs.AppError)
+	SaveList(context.Context, domain.ToDoList) (*domain.ToDoList, *errs.AppError)
+	GetOneListById(context.Context, string) (*domain.ToDoList, *errs.AppError)
+	UpdateOneListById(context.Context, string, domain.ToDoList) (*domain.ToDoList, *errs.AppError)
+	DeleteListById(context.Context, string) *errs.AppError
+	SetCollaborator(context.Context, string, domain.Collaborator) (*domain.ToDoList, *errs.AppError)
+	RemoveCollaborator(context.Context, string, string) *errs.AppError
 }
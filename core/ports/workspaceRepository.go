@@ -0,0 +1,22 @@
+/*
+ * package: ports
+ * --------------------
+ * Includes interface definitions for layer connections (services and repositories).
+ */
+
+package ports
+
+import (
+	"context"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+)
+
+//go:generate mockgen -destination=../../mocks/ports/mockWorkspaceRepository.go -package=ports github.com/luschnat-ziegler/toDoListAPI/core/ports WorkspaceRepository
+type WorkspaceRepository interface {
+	GetAll(context.Context, string) (*[]domain.Workspace, *errs.AppError)
+	GetOneById(context.Context, string) (*domain.Workspace, *errs.AppError)
+	Save(context.Context, domain.Workspace) (*domain.Workspace, *errs.AppError)
+	UpdateOneById(context.Context, string, domain.Workspace) (*domain.Workspace, *errs.AppError)
+	DeleteOneById(context.Context, string) *errs.AppError
+}
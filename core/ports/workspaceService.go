@@ -0,0 +1,22 @@
+/*
+ * package: ports
+ * --------------------
+ * Includes interface definitions for layer connections (services and repositories).
+ */
+
+package ports
+
+import (
+	"context"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+)
+
+//go:generate mockgen -destination=../../mocks/ports/mockWorkspaceService.go -package=ports github.com/luschnat-ziegler/toDoListAPI/core/ports WorkspaceService
+type WorkspaceService interface {
+	GetAllWorkspaces(context.Context) (*[]domain.Workspace, *errs.AppError)
+	SaveWorkspace(context.Context, domain.Workspace) (*domain.Workspace, *errs.AppError)
+	GetWorkspaceById(context.Context, string) (*domain.Workspace, *errs.AppError)
+	UpdateWorkspaceById(context.Context, string, domain.Workspace) (*domain.Workspace, *errs.AppError)
+	DeleteWorkspaceById(context.Context, string) *errs.AppError
+}
@@ -0,0 +1,189 @@
+/*
+ * package: services
+ * --------------------
+ * Includes service implementation(s) (as defined in package ports)
+ */
+
+package services
+
+import (
+	"context"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/core/ports"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/logger"
+	"github.com/luschnat-ziegler/toDoListAPI/tenancy"
+	"go.uber.org/zap"
+	"time"
+)
+
+type DefaultShareLinkService struct {
+	lists ports.ToDoListRepository
+	links ports.ShareLinkRepository
+}
+
+/*
+ * Method: DefaultShareLinkService.CreateShareLink
+ * --------------------
+ * Creates a read-only share link for a list (see domain.NewShareLink). Requires the owner role on the list (see
+ * authorize). The token is only contained in the returned link and cannot be retrieved later.
+ *
+ * ctx: the context.Context of the request
+ * listId: a string representation of the object id belonging to the list to be shared.
+ * expiresAt: the time the link expires at, or nil for links that do not expire. Must be in the future.
+ *
+ * returns: a pointer to the new domain.ShareLink including its token and nil error in case of success.
+ *          Otherwise nil and a pointer to an errs.AppError are returned.
+ */
+
+func (defaultShareLinkService DefaultShareLinkService) CreateShareLink(ctx context.Context, listId string, expiresAt *time.Time) (*domain.ShareLink, *errs.AppError) {
+	logger.FromContext(ctx).Debug("Creating share link", zap.String("list_id", listId))
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, errs.Localized(errs.NewBadRequestError, errs.MessageExpiryInPast)
+	}
+	parsedListId, appErr := defaultShareLinkService.authorizeOwner(ctx, listId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	shareLink, err := domain.NewShareLink(parsedListId.String(), subjectOf(ctx), expiresAt)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error generating share link token: " + err.Error())
+		return nil, errs.NewInternalError("Share link could not be created")
+	}
+	return defaultShareLinkService.links.Save(ctx, shareLink)
+}
+
+/*
+ * Method: DefaultShareLinkService.GetShareLinks
+ * --------------------
+ * Retrieves the active (not expired) share links of a list. Requires the owner role on the list (see authorize).
+ * Tokens are not included.
+ *
+ * ctx: the context.Context of the request
+ * listId: a string representation of the object id belonging to the shared list.
+ *
+ * returns: a pointer to a slice of domain.ShareLink and nil error in case of success.
+ *          Otherwise nil and a pointer to an errs.AppError are returned.
+ */
+
+func (defaultShareLinkService DefaultShareLinkService) GetShareLinks(ctx context.Context, listId string) (*[]domain.ShareLink, *errs.AppError) {
+	logger.FromContext(ctx).Debug("Retrieving share links", zap.String("list_id", listId))
+	parsedListId, err := defaultShareLinkService.authorizeOwner(ctx, listId)
+	if err != nil {
+		return nil, err
+	}
+
+	shareLinks, err := defaultShareLinkService.links.GetAllByListId(ctx, parsedListId.String())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	active := []domain.ShareLink{}
+	for _, shareLink := range *shareLinks {
+		if shareLink.IsActive(now) {
+			active = append(active, shareLink)
+		}
+	}
+	return &active, nil
+}
+
+/*
+ * Method: DefaultShareLinkService.RevokeShareLink
+ * --------------------
+ * Revokes a share link of a list. Requires the owner role on the list (see authorize).
+ *
+ * ctx: the context.Context of the request
+ * listId: a string representation of the object id belonging to the shared list.
+ * id: the id of the share link to be revoked.
+ *
+ * returns: nil in case of success.
+ *          Otherwise a pointer to an errs.AppError is returned.
+ */
+
+func (defaultShareLinkService DefaultShareLinkService) RevokeShareLink(ctx context.Context, listId string, id string) *errs.AppError {
+	logger.FromContext(ctx).Debug("Revoking share link", zap.String("list_id", listId))
+	parsedListId, err := defaultShareLinkService.authorizeOwner(ctx, listId)
+	if err != nil {
+		return err
+	}
+	return defaultShareLinkService.links.DeleteOneById(ctx, parsedListId.String(), id)
+}
+
+/*
+ * Method: DefaultShareLinkService.GetSharedList
+ * --------------------
+ * Retrieves the list shared by an active link, without authentication. Unknown, revoked and expired tokens are
+ * reported as not found. Owner, collaborators and workspace of the list are not disclosed.
+ *
+ * ctx: the context.Context of the request
+ * token: the token of the share link
+ *
+ * returns: a pointer to the domain.ToDoList and nil error in case of success.
+ *          Otherwise nil and a pointer to an errs.AppError are returned.
+ */
+
+func (defaultShareLinkService DefaultShareLinkService) GetSharedList(ctx context.Context, token string) (*domain.ToDoList, *errs.AppError) {
+	shareLink, err := defaultShareLinkService.links.GetOneByTokenHash(ctx, domain.HashShareToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if !shareLink.IsActive(time.Now()) {
+		return nil, errs.Localized(errs.NewNotFoundError, errs.MessageShareLinkNotFound)
+	}
+	logger.FromContext(ctx).Debug("Retrieving shared list", zap.String("list_id", shareLink.ListId))
+
+	listId, err := domain.ParseListId(shareLink.ListId)
+	if err != nil {
+		logger.FromContext(ctx).Error("Share link refers to malformed list id " + shareLink.ListId)
+		return nil, errs.NewInternalError("Database error")
+	}
+
+	list, err := defaultShareLinkService.lists.GetOneSharedById(tenancy.WithWorkspace(ctx, shareLink.WorkspaceId), listId)
+	if err != nil {
+		return nil, err
+	}
+	list.OwnerId = ""
+	list.Collaborators = nil
+	list.WorkspaceId = ""
+	return list, nil
+}
+
+/*
+ * Function: NewShareLinkService
+ * --------------------
+ * Instantiates a new DefaultShareLinkService for dependency injection.
+ *
+ * lists: an implementation of ports.ToDoListRepository holding the shared lists
+ * links: an implementation of ports.ShareLinkRepository
+ *
+ * returns: an instance of DefaultShareLinkService including the provided repositories.
+ */
+
+func NewShareLinkService(lists ports.ToDoListRepository, links ports.ShareLinkRepository) DefaultShareLinkService {
+	return DefaultShareLinkService{lists, links}
+}
+
+/*
+ * Method: DefaultShareLinkService.authorizeOwner
+ * --------------------
+ * Parses the id of a list and checks that the authenticated principal owns the list (see authorize).
+ *
+ * ctx: the context.Context of the request
+ * listId: a string representation of the object id belonging to the list.
+ *
+ * returns: the parsed domain.ListId and nil error if the principal is permitted.
+ *          Otherwise the zero value and a pointer to an errs.AppError (code 400 for malformed ids) are returned.
+ */
+
+func (defaultShareLinkService DefaultShareLinkService) authorizeOwner(ctx context.Context, listId string) (domain.ListId, *errs.AppError) {
+	parsedListId, err := domain.ParseListId(listId)
+	if err != nil {
+		return domain.ListId{}, err
+	}
+	if _, err := authorize(ctx, defaultShareLinkService.lists, parsedListId, domain.RoleOwner); err != nil {
+		return domain.ListId{}, err
+	}
+	return parsedListId, nil
+}
@@ -0,0 +1,180 @@
+/*
+ * package: services
+ * --------------------
+ * Includes service implementation(s) (as defined in package ports)
+ */
+
+package services
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/luschnat-ziegler/toDoListAPI/auth"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/tenancy"
+	"github.com/luschnat-ziegler/toDoListAPI/testUtils/dummies"
+	"github.com/luschnat-ziegler/toDoListAPI/testUtils/mocks/ports"
+	"net/http"
+	"testing"
+	"time"
+)
+
+/*
+ * function: setupShareLinkServiceTest
+ * --------------------
+ * Creates a DefaultShareLinkService with mocked repositories. The list repository holds the list dummies.DummyListId
+ * owned by alice and shared with bob as editor.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: the service, the mocked list repository and the mocked share link repository
+ */
+
+func setupShareLinkServiceTest(t *testing.T) (DefaultShareLinkService, *ports.MockToDoListRepository, *ports.MockShareLinkRepository) {
+	ctrl := gomock.NewController(t)
+	lists := ports.NewMockToDoListRepository(ctrl)
+	links := ports.NewMockShareLinkRepository(ctrl)
+	sharedList := domain.ToDoList{
+		OwnerId:       "alice",
+		Collaborators: []domain.Collaborator{{Subject: "bob", Role: domain.RoleEditor}},
+	}
+	lists.EXPECT().GetOneById(gomock.Any(), gomock.Any(), dummies.DummyListId).Return(&sharedList, nil).AnyTimes()
+	return NewShareLinkService(lists, links), lists, links
+}
+
+/*
+ * function: Test_DefaultShareLinkService_CreateShareLink_should_require_owner_role_and_future_expiry
+ * --------------------
+ * Tests if editors are refused to create links, expiries in the past are rejected and owners get a link with a
+ * token of which only the hash is saved.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_DefaultShareLinkService_CreateShareLink_should_require_owner_role_and_future_expiry(t *testing.T) {
+	service, _, links := setupShareLinkServiceTest(t)
+	links.EXPECT().Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, shareLink domain.ShareLink) (*domain.ShareLink, *errs.AppError) {
+			return &shareLink, nil
+		}).
+		Times(1)
+
+	editor := auth.WithPrincipal(context.Background(), auth.Principal{Subject: "bob", Method: auth.MethodJWT})
+	if _, err := service.CreateShareLink(editor, dummies.DummyListIdHex, nil); err == nil || err.Code != http.StatusForbidden {
+		t.Errorf("Forbidden error expected for editor, got %v", err)
+	}
+
+	owner := auth.WithPrincipal(context.Background(), auth.Principal{Subject: "alice", Method: auth.MethodJWT})
+	past := time.Now().Add(-time.Hour)
+	if _, err := service.CreateShareLink(owner, dummies.DummyListIdHex, &past); err == nil || err.Code != http.StatusBadRequest {
+		t.Errorf("Bad request error expected for past expiry, got %v", err)
+	}
+
+	shareLink, err := service.CreateShareLink(owner, dummies.DummyListIdHex, nil)
+	if err != nil {
+		t.Fatalf("Nil expected, error returned: %v", err.Code)
+	}
+	if len(shareLink.Token) < 40 || shareLink.TokenHash != domain.HashShareToken(shareLink.Token) || shareLink.CreatedBy != "alice" {
+		t.Errorf("Unexpected share link %+v", shareLink)
+	}
+}
+
+/*
+ * function: Test_DefaultShareLinkService_GetShareLinks_should_omit_expired_links
+ * --------------------
+ * Tests if expired links are not listed.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_DefaultShareLinkService_GetShareLinks_should_omit_expired_links(t *testing.T) {
+	service, _, links := setupShareLinkServiceTest(t)
+	past, future := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+	links.EXPECT().GetAllByListId(gomock.Any(), dummies.DummyListIdHex).
+		Return(&[]domain.ShareLink{{Id: "expired", ExpiresAt: &past}, {Id: "active", ExpiresAt: &future}, {Id: "forever"}}, nil).
+		Times(1)
+
+	owner := auth.WithPrincipal(context.Background(), auth.Principal{Subject: "alice", Method: auth.MethodJWT})
+	shareLinks, err := service.GetShareLinks(owner, dummies.DummyListIdHex)
+
+	if err != nil {
+		t.Fatalf("Nil expected, error returned: %v", err.Code)
+	}
+	if len(*shareLinks) != 2 || (*shareLinks)[0].Id != "active" || (*shareLinks)[1].Id != "forever" {
+		t.Errorf("Expected active links only, got %+v", *shareLinks)
+	}
+}
+
+/*
+ * function: Test_DefaultShareLinkService_GetSharedList_should_return_list_of_active_link_only
+ * --------------------
+ * Tests if the list of an active link is retrieved in the workspace of the link without owner and collaborators,
+ * and expired links are reported as not found.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_DefaultShareLinkService_GetSharedList_should_return_list_of_active_link_only(t *testing.T) {
+	service, lists, links := setupShareLinkServiceTest(t)
+	past := time.Now().Add(-time.Hour)
+	links.EXPECT().GetOneByTokenHash(gomock.Any(), domain.HashShareToken("active")).
+		Return(&domain.ShareLink{ListId: dummies.DummyListIdHex, WorkspaceId: "teama"}, nil).
+		Times(1)
+	links.EXPECT().GetOneByTokenHash(gomock.Any(), domain.HashShareToken("expired")).
+		Return(&domain.ShareLink{ListId: dummies.DummyListIdHex, ExpiresAt: &past}, nil).
+		Times(1)
+	lists.EXPECT().GetOneSharedById(gomock.Any(), dummies.DummyListId).
+		DoAndReturn(func(ctx context.Context, _ domain.ListId) (*domain.ToDoList, *errs.AppError) {
+			if workspaceId := tenancy.WorkspaceFromContext(ctx); workspaceId != "teama" {
+				t.Errorf("Expected workspace teama, got %q instead", workspaceId)
+			}
+			return &domain.ToDoList{Name: "shared", OwnerId: "alice", WorkspaceId: "teama",
+				Collaborators: []domain.Collaborator{{Subject: "bob", Role: domain.RoleViewer}}}, nil
+		}).
+		Times(1)
+
+	list, err := service.GetSharedList(context.Background(), "active")
+	if err != nil {
+		t.Fatalf("Nil expected, error returned: %v", err.Code)
+	}
+	if list.Name != "shared" || list.OwnerId != "" || list.WorkspaceId != "" || list.Collaborators != nil {
+		t.Errorf("Expected list without owner, workspace and collaborators, got %+v", list)
+	}
+
+	if _, err := service.GetSharedList(context.Background(), "expired"); err == nil || err.Code != http.StatusNotFound {
+		t.Errorf("Not found error expected for expired link, got %v", err)
+	}
+}
+
+/*
+ * function: Test_DefaultShareLinkService_should_return_400_for_malformed_list_ids
+ * --------------------
+ * Tests if every service method taking a list id rejects malformed ids with code 400 before any repository is
+ * called.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_DefaultShareLinkService_should_return_400_for_malformed_list_ids(t *testing.T) {
+	service, _, _ := setupShareLinkServiceTest(t)
+	owner := auth.WithPrincipal(context.Background(), auth.Principal{Subject: "alice", Method: auth.MethodJWT})
+
+	_, createErr := service.CreateShareLink(owner, "test_id", nil)
+	_, getErr := service.GetShareLinks(owner, "test_id")
+	revokeErr := service.RevokeShareLink(owner, "test_id", "link_id")
+
+	for _, err := range []*errs.AppError{createErr, getErr, revokeErr} {
+		if err == nil || err.Code != http.StatusBadRequest {
+			t.Errorf("Expected code 400, got %+v instead", err)
+		}
+	}
+}
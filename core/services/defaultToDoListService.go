@@ -7,9 +7,13 @@
 package services
 
 import (
+	"context"
+	"github.com/luschnat-ziegler/toDoListAPI/auth"
 	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
 	"github.com/luschnat-ziegler/toDoListAPI/core/ports"
 	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/logger"
+	"go.uber.org/zap"
 )
 
 type DefaultToDoListService struct {
@@ -19,14 +23,18 @@ type DefaultToDoListService struct {
 /*
  * Method: DefaultToDoListService.GetAllLists
  * --------------------
- * Retrieves all ToDoLists using the injected repository and does not modify their order or applies filtering.
+ * Retrieves all ToDoLists owned by or shared with the authenticated principal (see subjectOf) using the injected
+ * repository and does not modify their order or applies further filtering.
+ *
+ * ctx: the context.Context of the request
  *
  * returns: a pointer to a slice of domain.ToDoList and nil error in case of success.
  *          Otherwise nil and a pointer to an errs.AppError are returned.
  */
 
-func (defaultToDoListService DefaultToDoListService) GetAllLists() (*[]domain.ToDoList, *errs.AppError) {
-	lists, err := defaultToDoListService.repo.GetAll()
+func (defaultToDoListService DefaultToDoListService) GetAllLists(ctx context.Context) (*[]domain.ToDoList, *errs.AppError) {
+	logger.FromContext(ctx).Debug("Retrieving all lists")
+	lists, err := defaultToDoListService.repo.GetAll(ctx, subjectOf(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -39,18 +47,24 @@ func (defaultToDoListService DefaultToDoListService) GetAllLists() (*[]domain.To
  * Saves a list using the injected repository. The id is reset to its zero value
  * to overwrite a potentially existing client-side provided id and ensure proper
  * id assignment by the database.
- * Task ids are (re)assigned.
+ * Task ids are (re)assigned. The owner is set to the authenticated principal (see subjectOf),
+ * overwriting a potentially client-side provided owner id. Client-side provided collaborators are
+ * dropped, lists are shared using SetCollaborator.
  *
+ * ctx: the context.Context of the request
  * newList: a domain.ToDoList intended for saving.
  *
  * returns: a pointer to a domain.ToDoList and nil error in case of success.
  *          Otherwise nil and a pointer to an errs.AppError are returned.
  */
 
-func (defaultToDoListService DefaultToDoListService) SaveList(newList domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
+func (defaultToDoListService DefaultToDoListService) SaveList(ctx context.Context, newList domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
 	newList.ResetID()
 	newList.AssignTaskIDs()
-	list, err := defaultToDoListService.repo.Save(newList)
+	newList.OwnerId = subjectOf(ctx)
+	newList.Collaborators = nil
+	logger.FromContext(ctx).Debug("Saving list", zap.Int("tasks", len(newList.Tasks)))
+	list, err := defaultToDoListService.repo.Save(ctx, newList)
 	if err != nil {
 		return nil, err
 	}
@@ -60,16 +74,23 @@ func (defaultToDoListService DefaultToDoListService) SaveList(newList domain.ToD
 /*
  * Method: DefaultToDoListService.GetOneListById
  * --------------------
- * Retrieves a list with a provided id using the injected repository.
+ * Retrieves a list with a provided id using the injected repository. Lists neither owned by nor shared with the
+ * authenticated principal are reported as not found.
  *
+ * ctx: the context.Context of the request
  * id: a string representation of the requested list's object id
  *
  * returns: a pointer to a domain.ToDoList and nil error in case of success.
  *          Otherwise nil and a pointer to an errs.AppError are returned.
  */
 
-func (defaultToDoListService DefaultToDoListService) GetOneListById(id string) (*domain.ToDoList, *errs.AppError) {
-	list, err := defaultToDoListService.repo.GetOneById(id)
+func (defaultToDoListService DefaultToDoListService) GetOneListById(ctx context.Context, id string) (*domain.ToDoList, *errs.AppError) {
+	logger.FromContext(ctx).Debug("Retrieving list", zap.String("list_id", id))
+	listId, err := domain.ParseListId(id)
+	if err != nil {
+		return nil, err
+	}
+	list, err := defaultToDoListService.repo.GetOneById(ctx, subjectOf(ctx), listId)
 	if err != nil {
 		return nil, err
 	}
@@ -82,18 +103,28 @@ func (defaultToDoListService DefaultToDoListService) GetOneListById(id string) (
  * Updates an existing list using the injected repository. The id is reset to its zero value
  * to overwrite a potentially existing client-side provided id and ensure proper
  * id assignment by the database.
- * Task ids are (re)assigned.
+ * Task ids sent back by the client are kept, so they stay stable across updates; tasks without an id get a new one.
+ * Owner and collaborators can not be changed. Requires the editor role (see authorize).
  *
+ * ctx: the context.Context of the request
  * id: a string representation of the object id belonging to the list intended to be updated.
  *
  * returns: a pointer to a domain.ToDoList and nil error in case of success.
  *          Otherwise nil and a pointer to an errs.AppError are returned.
  */
 
-func (defaultToDoListService DefaultToDoListService) UpdateOneListById(id string, newList domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
+func (defaultToDoListService DefaultToDoListService) UpdateOneListById(ctx context.Context, id string, newList domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
 	newList.ResetID()
-	newList.AssignTaskIDs()
-	list, err := defaultToDoListService.repo.UpdateOneById(id, newList)
+	newList.AssignMissingTaskIDs()
+	logger.FromContext(ctx).Debug("Updating list", zap.String("list_id", id), zap.Int("tasks", len(newList.Tasks)))
+	listId, err := domain.ParseListId(id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := authorize(ctx, defaultToDoListService.repo, listId, domain.RoleEditor); err != nil {
+		return nil, err
+	}
+	list, err := defaultToDoListService.repo.UpdateOneById(ctx, subjectOf(ctx), listId, newList)
 	if err != nil {
 		return nil, err
 	}
@@ -103,22 +134,154 @@ func (defaultToDoListService DefaultToDoListService) UpdateOneListById(id string
 /*
  * Method: DefaultToDoListService.DeleteListById
  * --------------------
- * Deletes an existing list using the injected repository.
+ * Deletes an existing list using the injected repository. Requires the editor role (see authorize).
  *
+ * ctx: the context.Context of the request
  * id: a string representation of the object id belonging to the list intended for deletion.
  *
  * returns: nil in case of success.
  *          Otherwise a pointer to an errs.AppError is returned.
  */
 
-func (defaultToDoListService DefaultToDoListService) DeleteListById(id string) *errs.AppError {
-	err := defaultToDoListService.repo.DeleteOneById(id)
+func (defaultToDoListService DefaultToDoListService) DeleteListById(ctx context.Context, id string) *errs.AppError {
+	logger.FromContext(ctx).Debug("Deleting list", zap.String("list_id", id))
+	listId, err := domain.ParseListId(id)
+	if err != nil {
+		return err
+	}
+	if _, err := authorize(ctx, defaultToDoListService.repo, listId, domain.RoleEditor); err != nil {
+		return err
+	}
+	err = defaultToDoListService.repo.DeleteOneById(ctx, subjectOf(ctx), listId)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+/*
+ * Method: DefaultToDoListService.SetCollaborator
+ * --------------------
+ * Shares a list with a collaborator or changes the role of an existing collaborator. Requires the owner role
+ * (see authorize). The owner of the list can not be added as collaborator.
+ *
+ * ctx: the context.Context of the request
+ * id: a string representation of the object id belonging to the list to be shared.
+ * collaborator: the domain.Collaborator with subject and role to be granted.
+ *
+ * returns: a pointer to the updated domain.ToDoList and nil error in case of success.
+ *          Otherwise nil and a pointer to an errs.AppError are returned.
+ */
+
+func (defaultToDoListService DefaultToDoListService) SetCollaborator(ctx context.Context, id string, collaborator domain.Collaborator) (*domain.ToDoList, *errs.AppError) {
+	logger.FromContext(ctx).Debug("Setting collaborator", zap.String("list_id", id), zap.String("role", string(collaborator.Role)))
+	listId, err := domain.ParseListId(id)
+	if err != nil {
+		return nil, err
+	}
+	list, err := authorize(ctx, defaultToDoListService.repo, listId, domain.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if collaborator.Subject == list.OwnerId {
+		return nil, errs.Localized(errs.NewBadRequestError, errs.MessageOwnerAsCollaborator)
+	}
+
+	collaborators := make([]domain.Collaborator, 0, len(list.Collaborators)+1)
+	for _, existing := range list.Collaborators {
+		if existing.Subject != collaborator.Subject {
+			collaborators = append(collaborators, existing)
+		}
+	}
+	collaborators = append(collaborators, collaborator)
+
+	return defaultToDoListService.repo.UpdateCollaborators(ctx, subjectOf(ctx), listId, collaborators)
+}
+
+/*
+ * Method: DefaultToDoListService.RemoveCollaborator
+ * --------------------
+ * Stops sharing a list with a collaborator. Requires the owner role (see authorize).
+ *
+ * ctx: the context.Context of the request
+ * id: a string representation of the object id belonging to the list.
+ * subject: the subject of the collaborator to be removed.
+ *
+ * returns: nil in case of success.
+ *          Otherwise a pointer to an errs.AppError is returned.
+ */
+
+func (defaultToDoListService DefaultToDoListService) RemoveCollaborator(ctx context.Context, id string, subject string) *errs.AppError {
+	logger.FromContext(ctx).Debug("Removing collaborator", zap.String("list_id", id))
+	listId, err := domain.ParseListId(id)
+	if err != nil {
+		return err
+	}
+	list, err := authorize(ctx, defaultToDoListService.repo, listId, domain.RoleOwner)
+	if err != nil {
+		return err
+	}
+
+	collaborators := make([]domain.Collaborator, 0, len(list.Collaborators))
+	for _, existing := range list.Collaborators {
+		if existing.Subject != subject {
+			collaborators = append(collaborators, existing)
+		}
+	}
+	if len(collaborators) == len(list.Collaborators) {
+		return errs.Localized(errs.NewNotFoundError, errs.MessageCollaboratorNotFound, "subject", subject)
+	}
+
+	_, err = defaultToDoListService.repo.UpdateCollaborators(ctx, subjectOf(ctx), listId, collaborators)
+	return err
+}
+
+/*
+ * Function: authorize
+ * --------------------
+ * Retrieves a list accessible to the authenticated principal and checks that the principal's role on the list
+ * includes the required role.
+ *
+ * ctx: the context.Context of the request
+ * repo: the ports.ToDoListRepository holding the list
+ * id: the domain.ListId of the list
+ * required: the domain.Role required for the operation
+ *
+ * returns: a pointer to the domain.ToDoList and nil error if the principal is permitted.
+ *          Otherwise nil and a pointer to an errs.AppError (code 404 for inaccessible lists,
+ *          code 403 for insufficient roles) are returned.
+ */
+
+func authorize(ctx context.Context, repo ports.ToDoListRepository, id domain.ListId, required domain.Role) (*domain.ToDoList, *errs.AppError) {
+	list, err := repo.GetOneById(ctx, subjectOf(ctx), id)
+	if err != nil {
+		return nil, err
+	}
+	if role, _ := list.RoleOf(subjectOf(ctx)); !role.Includes(required) {
+		return nil, errs.Localized(errs.NewForbiddenError, errs.MessageRoleRequired, "role", string(required))
+	}
+	return list, nil
+}
+
+/*
+ * Function: subjectOf
+ * --------------------
+ * Determines the subject lists are scoped to: the subject of the authenticated principal stored in the
+ * context (see auth.WithPrincipal). Without an authenticated principal (authentication disabled) the empty
+ * subject is returned, which scopes requests to lists without an owner.
+ *
+ * ctx: the context.Context of the request
+ *
+ * returns: the subject as string
+ */
+
+func subjectOf(ctx context.Context) string {
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		return principal.Subject
+	}
+	return ""
+}
+
 /*
  * Function: NewToDoListService
  * --------------------
@@ -7,12 +7,16 @@
 package services
 
 import (
+	"context"
 	"github.com/golang/mock/gomock"
+	"github.com/luschnat-ziegler/toDoListAPI/auth"
 	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
 	ports2 "github.com/luschnat-ziegler/toDoListAPI/core/ports"
 	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/testUtils/dummies"
 	"github.com/luschnat-ziegler/toDoListAPI/testUtils/mocks/ports"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"net/http"
 	"reflect"
 	"testing"
 )
@@ -69,9 +73,9 @@ func Test_DefaultToDoListService_GetAllLists_should_return_lists_returned_by_rep
 		},
 	}
 
-	mockToDoListRepository.EXPECT().GetAll().Return(&mockToDoLists, nil).Times(1)
+	mockToDoListRepository.EXPECT().GetAll(gomock.Any(), "").Return(&mockToDoLists, nil).Times(1)
 
-	lists, err := defaultToDoListService.GetAllLists()
+	lists, err := defaultToDoListService.GetAllLists(context.Background())
 
 	if err != nil {
 		t.Errorf("Nil expected, error returned: %v", err.Code)
@@ -97,9 +101,9 @@ func Test_DefaultToDoListService_GetAllLists_should_return_error_returned_by_rep
 	defer teardown()
 
 	mockAppError := errs.NewInternalError("test error")
-	mockToDoListRepository.EXPECT().GetAll().Return(nil, mockAppError).Times(1)
+	mockToDoListRepository.EXPECT().GetAll(gomock.Any(), "").Return(nil, mockAppError).Times(1)
 
-	_, err := defaultToDoListService.GetAllLists()
+	_, err := defaultToDoListService.GetAllLists(context.Background())
 
 	if err == nil {
 		t.Error("Error expected, nil returned")
@@ -138,9 +142,9 @@ func Test_DefaultToDoListService_SaveList_should_return_list_returned_by_repo_me
 		},
 	}
 
-	mockToDoListRepository.EXPECT().Save(mockToDoList).Return(&mockToDoList, nil).Times(1)
+	mockToDoListRepository.EXPECT().Save(gomock.Any(), mockToDoList).Return(&mockToDoList, nil).Times(1)
 
-	list, err := defaultToDoListService.SaveList(mockToDoList)
+	list, err := defaultToDoListService.SaveList(context.Background(), mockToDoList)
 
 	if err != nil {
 		t.Errorf("Nil expected, error returned: %v", err.Code)
@@ -178,9 +182,9 @@ func Test_DefaultToDoListService_SaveList_should_return_error_returned_by_repo_m
 		},
 	}
 	mockAppError := errs.NewInternalError("test error")
-	mockToDoListRepository.EXPECT().Save(mockToDoList).Return(nil, mockAppError).Times(1)
+	mockToDoListRepository.EXPECT().Save(gomock.Any(), mockToDoList).Return(nil, mockAppError).Times(1)
 
-	_, err := defaultToDoListService.SaveList(mockToDoList)
+	_, err := defaultToDoListService.SaveList(context.Background(), mockToDoList)
 
 	if err == nil {
 		t.Error("Error expected, nil returned")
@@ -192,6 +196,70 @@ func Test_DefaultToDoListService_SaveList_should_return_error_returned_by_repo_m
 	}
 }
 
+/*
+ * function: Test_DefaultToDoListService_SaveList_should_set_owner_from_authenticated_principal
+ * --------------------
+ * Tests if the owner id of the list passed to the repository is the subject of the authenticated principal,
+ * overwriting a client-side provided owner id.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_DefaultToDoListService_SaveList_should_set_owner_from_authenticated_principal(t *testing.T) {
+	teardown := setupToDoListServiceTest(t)
+	defer teardown()
+
+	mockToDoList := domain.ToDoList{
+		OwnerId: "someone_else",
+		Name:    "mock list",
+		Tasks:   []domain.Task{{Name: "test task name"}},
+	}
+	mockToDoListRepository.EXPECT().Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, list domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
+			return &list, nil
+		}).
+		Times(1)
+
+	ctx := auth.WithPrincipal(context.Background(), auth.Principal{Subject: "test_owner", Method: auth.MethodJWT})
+	list, err := defaultToDoListService.SaveList(ctx, mockToDoList)
+
+	if err != nil {
+		t.Errorf("Nil expected, error returned: %v", err.Code)
+		return
+	}
+
+	if list.OwnerId != "test_owner" {
+		t.Errorf("Owner id \"test_owner\" expected, got %q", list.OwnerId)
+	}
+}
+
+/*
+ * function: Test_DefaultToDoListService_GetOneListById_should_scope_repo_call_to_authenticated_principal
+ * --------------------
+ * Tests if the subject of the authenticated principal is passed to the repository as owner id.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_DefaultToDoListService_GetOneListById_should_scope_repo_call_to_authenticated_principal(t *testing.T) {
+	teardown := setupToDoListServiceTest(t)
+	defer teardown()
+
+	mockAppError := errs.NewNotFoundError("No documents matching id test_id")
+	mockToDoListRepository.EXPECT().GetOneById(gomock.Any(), "test_owner", dummies.DummyListId).Return(nil, mockAppError).Times(1)
+
+	ctx := auth.WithPrincipal(context.Background(), auth.Principal{Subject: "test_owner", Method: auth.MethodAPIKey})
+	_, err := defaultToDoListService.GetOneListById(ctx, dummies.DummyListIdHex)
+
+	if err == nil || err.Code != mockAppError.Code {
+		t.Error("Not found error expected")
+	}
+}
+
 /*
  * function: Test_DefaultToDoListService_GetOneListById_should_return_list_returned_by_repo_method
  * --------------------
@@ -219,9 +287,9 @@ func Test_DefaultToDoListService_GetOneListById_should_return_list_returned_by_r
 		},
 	}
 
-	mockToDoListRepository.EXPECT().GetOneById("test_id").Return(&mockToDoList, nil).Times(1)
+	mockToDoListRepository.EXPECT().GetOneById(gomock.Any(), "", dummies.DummyListId).Return(&mockToDoList, nil).Times(1)
 
-	list, err := defaultToDoListService.GetOneListById("test_id")
+	list, err := defaultToDoListService.GetOneListById(context.Background(), dummies.DummyListIdHex)
 
 	if err != nil {
 		t.Errorf("Nil expected, error returned: %v", err.Code)
@@ -247,9 +315,9 @@ func Test_DefaultToDoListService_GetOneListById_should_return_error_returned_by_
 	defer teardown()
 
 	mockAppError := errs.NewInternalError("test error")
-	mockToDoListRepository.EXPECT().GetOneById("test_id").Return(nil, mockAppError).Times(1)
+	mockToDoListRepository.EXPECT().GetOneById(gomock.Any(), "", dummies.DummyListId).Return(nil, mockAppError).Times(1)
 
-	_, err := defaultToDoListService.GetOneListById("test_id")
+	_, err := defaultToDoListService.GetOneListById(context.Background(), dummies.DummyListIdHex)
 
 	if err == nil {
 		t.Error("Error expected, nil returned")
@@ -288,11 +356,12 @@ func Test_DefaultToDoListService_UpdateOneListById_should_return_list_returned_b
 		},
 	}
 
-	mockToDoListRepository.EXPECT().UpdateOneById("test_id", mockToDoList).
+	mockToDoListRepository.EXPECT().GetOneById(gomock.Any(), "", dummies.DummyListId).Return(&domain.ToDoList{}, nil).Times(1)
+	mockToDoListRepository.EXPECT().UpdateOneById(gomock.Any(), "", dummies.DummyListId, mockToDoList).
 		Return(&mockToDoList, nil).
 		Times(1)
 
-	list, err := defaultToDoListService.UpdateOneListById("test_id", mockToDoList)
+	list, err := defaultToDoListService.UpdateOneListById(context.Background(), dummies.DummyListIdHex, mockToDoList)
 
 	if err != nil {
 		t.Errorf("Nil expected, error returned: %v", err.Code)
@@ -303,6 +372,46 @@ func Test_DefaultToDoListService_UpdateOneListById_should_return_list_returned_b
 	}
 }
 
+/*
+ * function: Test_DefaultToDoListService_UpdateOneListById_should_keep_task_ids_sent_by_client
+ * --------------------
+ * Tests if the id of a task sent back by the client is passed to the repository unchanged, so task ids (and the
+ * iCalendar UIDs derived from them) stay stable across updates, while a new task is assigned an id.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_DefaultToDoListService_UpdateOneListById_should_keep_task_ids_sent_by_client(t *testing.T) {
+	teardown := setupToDoListServiceTest(t)
+	defer teardown()
+
+	var updated domain.ToDoList
+	mockToDoListRepository.EXPECT().GetOneById(gomock.Any(), "", dummies.DummyListId).Return(&domain.ToDoList{}, nil).Times(1)
+	mockToDoListRepository.EXPECT().UpdateOneById(gomock.Any(), "", dummies.DummyListId, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ domain.ListId, list domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
+			updated = list
+			return &list, nil
+		}).
+		Times(1)
+
+	newList := domain.ToDoList{
+		Name:  "mock list",
+		Tasks: []domain.Task{{Id: "existing_id", Name: "existing task"}, {Name: "new task"}},
+	}
+	if _, err := defaultToDoListService.UpdateOneListById(context.Background(), dummies.DummyListIdHex, newList); err != nil {
+		t.Fatalf("Nil expected, error returned: %v", err.Code)
+	}
+
+	if updated.Tasks[0].Id != "existing_id" {
+		t.Errorf("Expected task id existing_id to be kept, got %v instead", updated.Tasks[0].Id)
+	}
+	if updated.Tasks[1].Id == "" {
+		t.Error("Expected new task to be assigned an id")
+	}
+}
+
 /*
  * function: Test_DefaultToDoListService_UpdateOneListById_should_return_error_returned_by_repo_method
  * --------------------
@@ -330,11 +439,12 @@ func Test_DefaultToDoListService_UpdateOneListById_should_return_error_returned_
 		},
 	}
 	mockAppError := errs.NewInternalError("test error")
-	mockToDoListRepository.EXPECT().UpdateOneById("test_id", mockToDoList).
+	mockToDoListRepository.EXPECT().GetOneById(gomock.Any(), "", dummies.DummyListId).Return(&domain.ToDoList{}, nil).Times(1)
+	mockToDoListRepository.EXPECT().UpdateOneById(gomock.Any(), "", dummies.DummyListId, mockToDoList).
 		Return(nil, mockAppError).
 		Times(1)
 
-	_, err := defaultToDoListService.UpdateOneListById("test_id", mockToDoList)
+	_, err := defaultToDoListService.UpdateOneListById(context.Background(), dummies.DummyListIdHex, mockToDoList)
 
 	if err == nil {
 		t.Error("Error expected, nil returned")
@@ -360,8 +470,9 @@ func Test_DefaultToDoListService_DeleteList_should_return_nil_if_repo_method_ret
 	teardown := setupToDoListServiceTest(t)
 	defer teardown()
 
-	mockToDoListRepository.EXPECT().DeleteOneById("test_id").Return(nil).Times(1)
-	err := defaultToDoListService.DeleteListById("test_id")
+	mockToDoListRepository.EXPECT().GetOneById(gomock.Any(), "", dummies.DummyListId).Return(&domain.ToDoList{}, nil).Times(1)
+	mockToDoListRepository.EXPECT().DeleteOneById(gomock.Any(), "", dummies.DummyListId).Return(nil).Times(1)
+	err := defaultToDoListService.DeleteListById(context.Background(), dummies.DummyListIdHex)
 
 	if err != nil {
 		t.Error("Error returned, nil expected")
@@ -383,8 +494,9 @@ func Test_DefaultToDoListService_DeleteList_should_return_error_returned_by_repo
 	defer teardown()
 
 	mockAppError := errs.NewInternalError("test error")
-	mockToDoListRepository.EXPECT().DeleteOneById("test_id").Return(mockAppError).Times(1)
-	err := defaultToDoListService.DeleteListById("test_id")
+	mockToDoListRepository.EXPECT().GetOneById(gomock.Any(), "", dummies.DummyListId).Return(&domain.ToDoList{}, nil).Times(1)
+	mockToDoListRepository.EXPECT().DeleteOneById(gomock.Any(), "", dummies.DummyListId).Return(mockAppError).Times(1)
+	err := defaultToDoListService.DeleteListById(context.Background(), dummies.DummyListIdHex)
 
 	if err == nil {
 		t.Error("Nil returned, error expected")
@@ -395,3 +507,158 @@ func Test_DefaultToDoListService_DeleteList_should_return_error_returned_by_repo
 		t.Error("Data does not match mock return")
 	}
 }
+
+/*
+ * function: Test_DefaultToDoListService_UpdateOneListById_should_return_forbidden_error_for_viewers
+ * --------------------
+ * Tests if a collaborator with the viewer role is refused to update a list without calling the update repository
+ * method.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_DefaultToDoListService_UpdateOneListById_should_return_forbidden_error_for_viewers(t *testing.T) {
+	teardown := setupToDoListServiceTest(t)
+	defer teardown()
+
+	sharedList := domain.ToDoList{
+		OwnerId:       "alice",
+		Collaborators: []domain.Collaborator{{Subject: "bob", Role: domain.RoleViewer}},
+	}
+	mockToDoListRepository.EXPECT().GetOneById(gomock.Any(), "bob", dummies.DummyListId).Return(&sharedList, nil).Times(1)
+
+	ctx := auth.WithPrincipal(context.Background(), auth.Principal{Subject: "bob", Method: auth.MethodJWT})
+	_, err := defaultToDoListService.UpdateOneListById(ctx, dummies.DummyListIdHex, domain.ToDoList{Name: "new name"})
+
+	if err == nil || err.Code != http.StatusForbidden {
+		t.Errorf("Forbidden error expected, got %v", err)
+	}
+}
+
+/*
+ * function: Test_DefaultToDoListService_SetCollaborator_should_replace_role_of_existing_collaborator
+ * --------------------
+ * Tests if the owner can change the role of an existing collaborator, keeping all other collaborators.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_DefaultToDoListService_SetCollaborator_should_replace_role_of_existing_collaborator(t *testing.T) {
+	teardown := setupToDoListServiceTest(t)
+	defer teardown()
+
+	sharedList := domain.ToDoList{
+		OwnerId: "alice",
+		Collaborators: []domain.Collaborator{
+			{Subject: "bob", Role: domain.RoleViewer},
+			{Subject: "carol", Role: domain.RoleEditor},
+		},
+	}
+	expectedCollaborators := []domain.Collaborator{
+		{Subject: "carol", Role: domain.RoleEditor},
+		{Subject: "bob", Role: domain.RoleEditor},
+	}
+	mockToDoListRepository.EXPECT().GetOneById(gomock.Any(), "alice", dummies.DummyListId).Return(&sharedList, nil).Times(1)
+	mockToDoListRepository.EXPECT().UpdateCollaborators(gomock.Any(), "alice", dummies.DummyListId, expectedCollaborators).
+		Return(&sharedList, nil).
+		Times(1)
+
+	ctx := auth.WithPrincipal(context.Background(), auth.Principal{Subject: "alice", Method: auth.MethodJWT})
+	_, err := defaultToDoListService.SetCollaborator(ctx, dummies.DummyListIdHex, domain.Collaborator{Subject: "bob", Role: domain.RoleEditor})
+
+	if err != nil {
+		t.Errorf("Nil expected, error returned: %v", err.Code)
+	}
+}
+
+/*
+ * function: Test_DefaultToDoListService_SetCollaborator_should_return_forbidden_error_for_editors
+ * --------------------
+ * Tests if a collaborator with the editor role is refused to manage sharing.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_DefaultToDoListService_SetCollaborator_should_return_forbidden_error_for_editors(t *testing.T) {
+	teardown := setupToDoListServiceTest(t)
+	defer teardown()
+
+	sharedList := domain.ToDoList{
+		OwnerId:       "alice",
+		Collaborators: []domain.Collaborator{{Subject: "bob", Role: domain.RoleEditor}},
+	}
+	mockToDoListRepository.EXPECT().GetOneById(gomock.Any(), "bob", dummies.DummyListId).Return(&sharedList, nil).Times(1)
+
+	ctx := auth.WithPrincipal(context.Background(), auth.Principal{Subject: "bob", Method: auth.MethodJWT})
+	_, err := defaultToDoListService.SetCollaborator(ctx, dummies.DummyListIdHex, domain.Collaborator{Subject: "carol", Role: domain.RoleViewer})
+
+	if err == nil || err.Code != http.StatusForbidden {
+		t.Errorf("Forbidden error expected, got %v", err)
+	}
+}
+
+/*
+ * function: Test_DefaultToDoListService_RemoveCollaborator_should_return_not_found_error_for_unknown_collaborator
+ * --------------------
+ * Tests if removing a subject the list is not shared with results in a not found error without calling the
+ * update repository method.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_DefaultToDoListService_RemoveCollaborator_should_return_not_found_error_for_unknown_collaborator(t *testing.T) {
+	teardown := setupToDoListServiceTest(t)
+	defer teardown()
+
+	sharedList := domain.ToDoList{
+		OwnerId:       "alice",
+		Collaborators: []domain.Collaborator{{Subject: "bob", Role: domain.RoleEditor}},
+	}
+	mockToDoListRepository.EXPECT().GetOneById(gomock.Any(), "alice", dummies.DummyListId).Return(&sharedList, nil).Times(1)
+
+	ctx := auth.WithPrincipal(context.Background(), auth.Principal{Subject: "alice", Method: auth.MethodJWT})
+	err := defaultToDoListService.RemoveCollaborator(ctx, dummies.DummyListIdHex, "carol")
+
+	if err == nil || err.Code != http.StatusNotFound {
+		t.Errorf("Not found error expected, got %v", err)
+	}
+}
+
+/*
+ * function: Test_DefaultToDoListService_should_return_400_for_malformed_ids_without_calling_repo
+ * --------------------
+ * Tests if every service method taking a list id rejects malformed ids with code 400 and error code bad_request
+ * before the repository is called.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_DefaultToDoListService_should_return_400_for_malformed_ids_without_calling_repo(t *testing.T) {
+	teardown := setupToDoListServiceTest(t)
+	defer teardown()
+
+	ctx := context.Background()
+	for _, id := range []string{"", "test_id", "601be448b9b5e15374b1e84", "601be448b9b5e15374b1e842x"} {
+		_, getErr := defaultToDoListService.GetOneListById(ctx, id)
+		_, updateErr := defaultToDoListService.UpdateOneListById(ctx, id, dummies.DummyListValid)
+		deleteErr := defaultToDoListService.DeleteListById(ctx, id)
+		_, setErr := defaultToDoListService.SetCollaborator(ctx, id, domain.Collaborator{Subject: "bob", Role: domain.RoleViewer})
+		removeErr := defaultToDoListService.RemoveCollaborator(ctx, id, "bob")
+
+		for _, err := range []*errs.AppError{getErr, updateErr, deleteErr, setErr, removeErr} {
+			if err == nil || err.Code != http.StatusBadRequest || err.ErrorCode != errs.CodeBadRequest {
+				t.Errorf("Expected code 400 for id %q, got %+v instead", id, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,159 @@
+/*
+ * package: services
+ * --------------------
+ * Includes service implementation(s) (as defined in package ports)
+ */
+
+package services
+
+import (
+	"context"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/core/ports"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/logger"
+	"go.uber.org/zap"
+)
+
+type DefaultWorkspaceService struct {
+	repo ports.WorkspaceRepository
+}
+
+/*
+ * Method: DefaultWorkspaceService.GetAllWorkspaces
+ * --------------------
+ * Retrieves all workspaces the authenticated principal (see subjectOf) owns or is a member of using the injected
+ * repository.
+ *
+ * ctx: the context.Context of the request
+ *
+ * returns: a pointer to a slice of domain.Workspace and nil error in case of success.
+ *          Otherwise nil and a pointer to an errs.AppError are returned.
+ */
+
+func (defaultWorkspaceService DefaultWorkspaceService) GetAllWorkspaces(ctx context.Context) (*[]domain.Workspace, *errs.AppError) {
+	logger.FromContext(ctx).Debug("Retrieving all workspaces")
+	return defaultWorkspaceService.repo.GetAll(ctx, subjectOf(ctx))
+}
+
+/*
+ * Method: DefaultWorkspaceService.SaveWorkspace
+ * --------------------
+ * Saves a workspace using the injected repository. The owner is set to the authenticated principal, overwriting
+ * a potentially client-side provided owner id.
+ *
+ * ctx: the context.Context of the request
+ * newWorkspace: a domain.Workspace intended for saving.
+ *
+ * returns: a pointer to a domain.Workspace and nil error in case of success.
+ *          Otherwise nil and a pointer to an errs.AppError are returned.
+ */
+
+func (defaultWorkspaceService DefaultWorkspaceService) SaveWorkspace(ctx context.Context, newWorkspace domain.Workspace) (*domain.Workspace, *errs.AppError) {
+	newWorkspace.OwnerId = subjectOf(ctx)
+	logger.FromContext(ctx).Debug("Saving workspace", zap.String("workspace_id", newWorkspace.Id))
+	return defaultWorkspaceService.repo.Save(ctx, newWorkspace)
+}
+
+/*
+ * Method: DefaultWorkspaceService.GetWorkspaceById
+ * --------------------
+ * Retrieves a workspace with a provided id using the injected repository. Workspaces the authenticated principal
+ * does not belong to are reported as not found.
+ *
+ * ctx: the context.Context of the request
+ * id: the id of the requested workspace
+ *
+ * returns: a pointer to a domain.Workspace and nil error in case of success.
+ *          Otherwise nil and a pointer to an errs.AppError are returned.
+ */
+
+func (defaultWorkspaceService DefaultWorkspaceService) GetWorkspaceById(ctx context.Context, id string) (*domain.Workspace, *errs.AppError) {
+	logger.FromContext(ctx).Debug("Retrieving workspace", zap.String("workspace_id", id))
+	workspace, err := defaultWorkspaceService.repo.GetOneById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !workspace.IsMember(subjectOf(ctx)) {
+		return nil, errs.Localized(errs.NewNotFoundError, errs.MessageWorkspaceNotFound, "id", id)
+	}
+	return workspace, nil
+}
+
+/*
+ * Method: DefaultWorkspaceService.UpdateWorkspaceById
+ * --------------------
+ * Updates name and members of an existing workspace using the injected repository. Only the owner of the
+ * workspace may update it.
+ *
+ * ctx: the context.Context of the request
+ * id: the id of the workspace intended to be updated.
+ * newWorkspace: the domain.Workspace with the new name and members.
+ *
+ * returns: a pointer to the updated domain.Workspace and nil error in case of success.
+ *          Otherwise nil and a pointer to an errs.AppError are returned.
+ */
+
+func (defaultWorkspaceService DefaultWorkspaceService) UpdateWorkspaceById(ctx context.Context, id string, newWorkspace domain.Workspace) (*domain.Workspace, *errs.AppError) {
+	if err := defaultWorkspaceService.authorizeOwner(ctx, id); err != nil {
+		return nil, err
+	}
+	return defaultWorkspaceService.repo.UpdateOneById(ctx, id, newWorkspace)
+}
+
+/*
+ * Method: DefaultWorkspaceService.DeleteWorkspaceById
+ * --------------------
+ * Deletes an existing workspace using the injected repository. Only the owner of the workspace may delete it.
+ * The lists of the workspace are deleted as well.
+ *
+ * ctx: the context.Context of the request
+ * id: the id of the workspace intended for deletion.
+ *
+ * returns: nil in case of success.
+ *          Otherwise a pointer to an errs.AppError is returned.
+ */
+
+func (defaultWorkspaceService DefaultWorkspaceService) DeleteWorkspaceById(ctx context.Context, id string) *errs.AppError {
+	if err := defaultWorkspaceService.authorizeOwner(ctx, id); err != nil {
+		return err
+	}
+	return defaultWorkspaceService.repo.DeleteOneById(ctx, id)
+}
+
+/*
+ * Method: DefaultWorkspaceService.authorizeOwner
+ * --------------------
+ * Checks that the authenticated principal owns a workspace.
+ *
+ * ctx: the context.Context of the request
+ * id: the id of the workspace
+ *
+ * returns: nil if the principal owns the workspace. Otherwise a pointer to an errs.AppError (code 404 if the
+ *          principal does not belong to the workspace, code 403 for members) is returned.
+ */
+
+func (defaultWorkspaceService DefaultWorkspaceService) authorizeOwner(ctx context.Context, id string) *errs.AppError {
+	workspace, err := defaultWorkspaceService.GetWorkspaceById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if workspace.OwnerId != subjectOf(ctx) {
+		return errs.Localized(errs.NewForbiddenError, errs.MessageWorkspaceOwnerOnly)
+	}
+	return nil
+}
+
+/*
+ * Function: NewWorkspaceService
+ * --------------------
+ * Instantiates a new DefaultWorkspaceService for dependency injection.
+ *
+ * repo: an implementation of ports.WorkspaceRepository
+ *
+ * returns: an instance of DefaultWorkspaceService including the provided repository.
+ */
+
+func NewWorkspaceService(repo ports.WorkspaceRepository) DefaultWorkspaceService {
+	return DefaultWorkspaceService{repo}
+}
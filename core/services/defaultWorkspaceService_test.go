@@ -0,0 +1,105 @@
+/*
+ * package: services
+ * --------------------
+ * Includes service implementation(s) (as defined in package ports)
+ */
+
+package services
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/luschnat-ziegler/toDoListAPI/auth"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/testUtils/mocks/ports"
+	"net/http"
+	"testing"
+)
+
+/*
+ * function: setupWorkspaceServiceTest
+ * --------------------
+ * Creates a DefaultWorkspaceService with a mocked repository holding one workspace owned by alice with member bob.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: the service and the mocked repository
+ */
+
+func setupWorkspaceServiceTest(t *testing.T) (DefaultWorkspaceService, *ports.MockWorkspaceRepository) {
+	repo := ports.NewMockWorkspaceRepository(gomock.NewController(t))
+	workspace := domain.Workspace{Id: "teama", OwnerId: "alice", Name: "Team A", Members: []string{"bob"}}
+	repo.EXPECT().GetOneById(gomock.Any(), "teama").Return(&workspace, nil).AnyTimes()
+	return NewWorkspaceService(repo), repo
+}
+
+/*
+ * function: Test_DefaultWorkspaceService_SaveWorkspace_should_set_owner_from_authenticated_principal
+ * --------------------
+ * Tests if the owner of a new workspace is the subject of the authenticated principal.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_DefaultWorkspaceService_SaveWorkspace_should_set_owner_from_authenticated_principal(t *testing.T) {
+	service, repo := setupWorkspaceServiceTest(t)
+	repo.EXPECT().Save(gomock.Any(), domain.Workspace{Id: "teamb", OwnerId: "alice", Name: "Team B"}).
+		Return(&domain.Workspace{}, nil).
+		Times(1)
+
+	ctx := auth.WithPrincipal(context.Background(), auth.Principal{Subject: "alice", Method: auth.MethodJWT})
+	if _, err := service.SaveWorkspace(ctx, domain.Workspace{Id: "teamb", OwnerId: "mallory", Name: "Team B"}); err != nil {
+		t.Errorf("Nil expected, error returned: %v", err.Code)
+	}
+}
+
+/*
+ * function: Test_DefaultWorkspaceService_GetWorkspaceById_should_hide_workspaces_of_others
+ * --------------------
+ * Tests if members get the workspace and subjects not belonging to it get a not found error.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_DefaultWorkspaceService_GetWorkspaceById_should_hide_workspaces_of_others(t *testing.T) {
+	service, _ := setupWorkspaceServiceTest(t)
+
+	member := auth.WithPrincipal(context.Background(), auth.Principal{Subject: "bob", Method: auth.MethodJWT})
+	if _, err := service.GetWorkspaceById(member, "teama"); err != nil {
+		t.Errorf("Nil expected for member, error returned: %v", err.Code)
+	}
+
+	stranger := auth.WithPrincipal(context.Background(), auth.Principal{Subject: "mallory", Method: auth.MethodJWT})
+	if _, err := service.GetWorkspaceById(stranger, "teama"); err == nil || err.Code != http.StatusNotFound {
+		t.Errorf("Not found error expected for stranger, got %v", err)
+	}
+}
+
+/*
+ * function: Test_DefaultWorkspaceService_DeleteWorkspaceById_should_return_forbidden_error_for_members
+ * --------------------
+ * Tests if members are refused to delete a workspace while the owner may delete it.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_DefaultWorkspaceService_DeleteWorkspaceById_should_return_forbidden_error_for_members(t *testing.T) {
+	service, repo := setupWorkspaceServiceTest(t)
+	repo.EXPECT().DeleteOneById(gomock.Any(), "teama").Return(nil).Times(1)
+
+	member := auth.WithPrincipal(context.Background(), auth.Principal{Subject: "bob", Method: auth.MethodJWT})
+	if err := service.DeleteWorkspaceById(member, "teama"); err == nil || err.Code != http.StatusForbidden {
+		t.Errorf("Forbidden error expected for member, got %v", err)
+	}
+
+	owner := auth.WithPrincipal(context.Background(), auth.Principal{Subject: "alice", Method: auth.MethodJWT})
+	if err := service.DeleteWorkspaceById(owner, "teama"); err != nil {
+		t.Errorf("Nil expected for owner, error returned: %v", err.Code)
+	}
+}
@@ -0,0 +1,126 @@
+/*
+ * package: services
+ * --------------------
+ * Includes service implementation(s) (as defined in package ports)
+ */
+
+package services
+
+import (
+	"context"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/core/ports"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type TracedToDoListService struct {
+	service ports.ToDoListService
+}
+
+/*
+ * Function: NewTracedToDoListService
+ * --------------------
+ * Decorates a service to trace every call in a span named "ToDoListService.<method>" (see tracing.Start).
+ *
+ * service: the ports.ToDoListService to be decorated
+ *
+ * returns: a TracedToDoListService
+ */
+
+func NewTracedToDoListService(service ports.ToDoListService) TracedToDoListService {
+	return TracedToDoListService{service: service}
+}
+
+/*
+ * Method: TracedToDoListService.GetAllLists
+ * --------------------
+ * See ports.ToDoListService.
+ */
+
+func (s TracedToDoListService) GetAllLists(ctx context.Context) (*[]domain.ToDoList, *errs.AppError) {
+	ctx, span := tracing.Start(ctx, "ToDoListService.GetAllLists")
+	lists, appErr := s.service.GetAllLists(ctx)
+	tracing.End(span, appErr)
+	return lists, appErr
+}
+
+/*
+ * Method: TracedToDoListService.SaveList
+ * --------------------
+ * See ports.ToDoListService.
+ */
+
+func (s TracedToDoListService) SaveList(ctx context.Context, newList domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
+	ctx, span := tracing.Start(ctx, "ToDoListService.SaveList")
+	list, appErr := s.service.SaveList(ctx, newList)
+	tracing.End(span, appErr)
+	return list, appErr
+}
+
+/*
+ * Method: TracedToDoListService.GetOneListById
+ * --------------------
+ * See ports.ToDoListService.
+ */
+
+func (s TracedToDoListService) GetOneListById(ctx context.Context, id string) (*domain.ToDoList, *errs.AppError) {
+	ctx, span := tracing.Start(ctx, "ToDoListService.GetOneListById", attribute.String("todolist.id", id))
+	list, appErr := s.service.GetOneListById(ctx, id)
+	tracing.End(span, appErr)
+	return list, appErr
+}
+
+/*
+ * Method: TracedToDoListService.UpdateOneListById
+ * --------------------
+ * See ports.ToDoListService.
+ */
+
+func (s TracedToDoListService) UpdateOneListById(ctx context.Context, id string, newList domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
+	ctx, span := tracing.Start(ctx, "ToDoListService.UpdateOneListById", attribute.String("todolist.id", id))
+	list, appErr := s.service.UpdateOneListById(ctx, id, newList)
+	tracing.End(span, appErr)
+	return list, appErr
+}
+
+/*
+ * Method: TracedToDoListService.DeleteListById
+ * --------------------
+ * See ports.ToDoListService.
+ */
+
+func (s TracedToDoListService) DeleteListById(ctx context.Context, id string) *errs.AppError {
+	ctx, span := tracing.Start(ctx, "ToDoListService.DeleteListById", attribute.String("todolist.id", id))
+	appErr := s.service.DeleteListById(ctx, id)
+	tracing.End(span, appErr)
+	return appErr
+}
+
+/*
+ * Method: TracedToDoListService.SetCollaborator
+ * --------------------
+ * See ports.ToDoListService.
+ */
+
+func (s TracedToDoListService) SetCollaborator(ctx context.Context, id string, collaborator domain.Collaborator) (*domain.ToDoList, *errs.AppError) {
+	ctx, span := tracing.Start(ctx, "ToDoListService.SetCollaborator",
+		attribute.String("todolist.id", id), attribute.String("todolist.role", string(collaborator.Role)))
+	list, appErr := s.service.SetCollaborator(ctx, id, collaborator)
+	tracing.End(span, appErr)
+	return list, appErr
+}
+
+/*
+ * Method: TracedToDoListService.RemoveCollaborator
+ * --------------------
+ * See ports.ToDoListService.
+ */
+
+func (s TracedToDoListService) RemoveCollaborator(ctx context.Context, id string, subject string) *errs.AppError {
+	ctx, span := tracing.Start(ctx, "ToDoListService.RemoveCollaborator", attribute.String("todolist.id", id))
+	appErr := s.service.RemoveCollaborator(ctx, id, subject)
+	tracing.End(span, appErr)
+	return appErr
+}
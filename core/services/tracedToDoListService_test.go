@@ -0,0 +1,72 @@
+/*
+ * package: services
+ * --------------------
+ * Includes service implementation(s) (as defined in package ports)
+ */
+
+package services
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/testUtils/mocks/ports"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"testing"
+)
+
+/*
+ * function: Test_TracedToDoListService_should_call_service_within_span
+ * --------------------
+ * Tests if the decorator calls the wrapped service with a context carrying a span named after the method, returns
+ * its results unchanged and marks the span as failed for internal errors.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_TracedToDoListService_should_call_service_within_span(t *testing.T) {
+	spans := tracetest.NewSpanRecorder()
+	previousProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)))
+	defer otel.SetTracerProvider(previousProvider)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockToDoListService := ports.NewMockToDoListService(ctrl)
+	tracedService := NewTracedToDoListService(mockToDoListService)
+
+	dummyError := errs.NewInternalError("Database error")
+	var innerSpan trace.SpanContext
+	mockToDoListService.EXPECT().GetOneListById(gomock.Any(), "test_id").DoAndReturn(
+		func(ctx context.Context, id string) (*domain.ToDoList, *errs.AppError) {
+			innerSpan = trace.SpanContextFromContext(ctx)
+			return nil, dummyError
+		}).Times(1)
+
+	list, appErr := tracedService.GetOneListById(context.Background(), "test_id")
+
+	if list != nil || appErr != dummyError {
+		t.Errorf("Expected wrapped results, got %v and %v instead", list, appErr)
+	}
+
+	ended := spans.Ended()
+	if len(ended) != 1 {
+		t.Fatalf("Expected 1 span, got %v instead", len(ended))
+	}
+	if ended[0].Name() != "ToDoListService.GetOneListById" {
+		t.Errorf("Unexpected span name %v", ended[0].Name())
+	}
+	if ended[0].SpanContext().SpanID() != innerSpan.SpanID() {
+		t.Error("Expected wrapped service to be called within the span")
+	}
+	if ended[0].Status().Code != codes.Error {
+		t.Errorf("Expected failed span, got %v instead", ended[0].Status())
+	}
+}
@@ -0,0 +1,230 @@
+/*
+ * package: errs
+ * --------------------
+ * Includes definitions of types representing custom errors.
+ */
+
+package errs
+
+import (
+	"sort"
+	"strings"
+)
+
+const DefaultLocale = "en"
+
+const (
+	MessageListNotFound         = "list_not_found"
+	MessageWorkspaceNotFound    = "workspace_not_found"
+	MessageWorkspaceClaim       = "workspace_claim_invalid"
+	MessageShareLinkNotFound    = "share_link_not_found"
+	MessageCollaboratorNotFound = "collaborator_not_found"
+	MessageRouteNotFound        = "route_not_found"
+	MessageRouteMethod          = "route_method_not_allowed"
+	MessageInvalidId            = "invalid_id"
+	MessageAuthRequired         = "authentication_required"
+	MessageBodyLimit            = "body_limit_exceeded"
+	MessageRetryLater           = "retry_later"
+	MessageWorkspaceRestricted  = "workspace_restricted"
+	MessageWorkspaceExists      = "workspace_exists"
+	MessageWorkspaceOwnerOnly   = "workspace_owner_only"
+	MessageListInOtherWorkspace = "list_in_other_workspace"
+	MessageShareLinkIdNotFound  = "share_link_id_not_found"
+	MessageExpiryInPast         = "expiry_in_past"
+	MessageOwnerAsCollaborator  = "owner_as_collaborator"
+	MessageRoleRequired         = "role_required"
+	MessageAuthScheme           = "authorization_scheme"
+	MessageInvalidAPIKey        = "invalid_api_key"
+	MessageInvalidToken         = "invalid_token"
+	MessageTokenSubject         = "token_subject_missing"
+	MessageCORSOrigin           = "cors_origin"
+	MessageCORSMethod           = "cors_method"
+	MessageCORSHeader           = "cors_header"
+	MessageNotAcceptable        = "media_types_not_acceptable"
+	MessageContentType          = "unsupported_content_type"
+	MessageImportEmpty          = "import_empty"
+	MessageFormField            = "form_field_missing"
+)
+
+type catalog struct {
+	titles     map[string]string
+	details    map[string]string
+	violations map[string]string
+}
+
+var catalogs = map[string]catalog{
+	"en": {
+		titles: problemTitles,
+		details: map[string]string{
+			MessageListNotFound:         "No documents matching id {id}",
+			MessageWorkspaceNotFound:    "No workspace matching id {id}",
+			MessageWorkspaceClaim:       "Token claims an invalid workspace",
+			MessageShareLinkNotFound:    "No share link matching token",
+			MessageCollaboratorNotFound: "No collaborator matching subject {subject}",
+			MessageRouteNotFound:        "No route matching path {path}",
+			MessageRouteMethod:          "Method {method} not allowed for path {path}",
+			MessageInvalidId:            "ID is invalid",
+			MessageAuthRequired:         "Authentication required",
+			MessageBodyLimit:            "Request body exceeds the limit of {limit} bytes",
+			MessageRetryLater:           "Rate limit exceeded, retry in {seconds} seconds",
+			MessageWorkspaceRestricted:  "Token is restricted to workspace {id}",
+			MessageWorkspaceExists:      "Workspace {id} already exists",
+			MessageWorkspaceOwnerOnly:   "Only the owner may change the workspace",
+			MessageListInOtherWorkspace: "List {id} belongs to another workspace",
+			MessageShareLinkIdNotFound:  "No share link matching id {id}",
+			MessageExpiryInPast:         "Expiry must be in the future",
+			MessageOwnerAsCollaborator:  "The owner can not be added as collaborator",
+			MessageRoleRequired:         "Role {role} required",
+			MessageAuthScheme:           "Unsupported authorization scheme, expected Bearer",
+			MessageInvalidAPIKey:        "Invalid API key",
+			MessageInvalidToken:         "Invalid token: {reason}",
+			MessageTokenSubject:         "Invalid token: subject is missing",
+			MessageCORSOrigin:           "Origin {origin} is not allowed",
+			MessageCORSMethod:           "Method {method} is not allowed",
+			MessageCORSHeader:           "Header {header} is not allowed",
+			MessageNotAcceptable:        "Not acceptable, supported media types: {types}",
+			MessageContentType:          "Unsupported content type {type}, expected one of: {types}",
+			MessageImportEmpty:          "No lists found in {format} document",
+			MessageFormField:            "Missing form field {field}",
+		},
+		violations: map[string]string{
+			"required":          "is required",
+			"max":               "must be at most {param} characters long",
+			"max_items":         "must contain at most {param} items",
+			"oneof":             "must be one of: {param}",
+			"lowercase":         "must be lowercase",
+			"alphanum":          "must contain letters and digits only",
+			"unique_task_names": "must not contain tasks with the same name",
+			"invalid":           "is invalid",
+		},
+	},
+	"de": {
+		titles: map[string]string{
+			CodeBadRequest:           "Ungültige Anfrage",
+			CodeMalformedBody:        "Fehlerhafter Anfrageinhalt",
+			CodeValidationFailed:     "Validierung fehlgeschlagen",
+			CodeImportFailed:         "Import fehlgeschlagen",
+			CodeUnauthorized:         "Authentifizierung erforderlich",
+			CodeForbidden:            "Zugriff verweigert",
+			CodeNotFound:             "Ressource nicht gefunden",
+			CodeMethodNotAllowed:     "Methode nicht erlaubt",
+			CodeNotAcceptable:        "Medientyp nicht akzeptabel",
+			CodeConflict:             "Ressource existiert bereits",
+			CodeBodyTooLarge:         "Anfrageinhalt zu groß",
+			CodeUnsupportedMediaType: "Nicht unterstützter Medientyp",
+			CodeRateLimited:          "Anfragelimit überschritten",
+			CodeInternal:             "Interner Serverfehler",
+		},
+		details: map[string]string{
+			CodeBadRequest:              "Die Anfrage ist ungültig",
+			CodeMalformedBody:           "Der Anfrageinhalt konnte nicht gelesen werden",
+			CodeValidationFailed:        "Die Anfrage enthält ungültige Felder",
+			CodeImportFailed:            "Import fehlgeschlagen, es wurden keine Listen gespeichert",
+			CodeUnauthorized:            "Die Authentifizierung ist fehlgeschlagen",
+			CodeForbidden:               "Keine Berechtigung für diese Anfrage",
+			CodeNotFound:                "Die angeforderte Ressource wurde nicht gefunden",
+			CodeMethodNotAllowed:        "Die Methode ist für diesen Pfad nicht erlaubt",
+			CodeNotAcceptable:           "Keiner der akzeptierten Medientypen wird unterstützt",
+			CodeConflict:                "Die Ressource existiert bereits",
+			CodeBodyTooLarge:            "Der Anfrageinhalt überschreitet die zulässige Größe",
+			CodeUnsupportedMediaType:    "Der Medientyp des Anfrageinhalts wird nicht unterstützt",
+			CodeRateLimited:             "Zu viele Anfragen, bitte später erneut versuchen",
+			CodeInternal:                "Ein interner Fehler ist aufgetreten",
+			MessageListNotFound:         "Keine Liste mit der ID {id} gefunden",
+			MessageWorkspaceNotFound:    "Kein Arbeitsbereich mit der ID {id} gefunden",
+			MessageWorkspaceClaim:       "Das Token beansprucht einen ungültigen Arbeitsbereich",
+			MessageShareLinkNotFound:    "Kein Freigabelink zu diesem Token gefunden",
+			MessageCollaboratorNotFound: "Kein Mitwirkender mit dem Subject {subject} gefunden",
+			MessageRouteNotFound:        "Kein Endpunkt für den Pfad {path} gefunden",
+			MessageRouteMethod:          "Die Methode {method} ist für den Pfad {path} nicht erlaubt",
+			MessageInvalidId:            "Die ID ist ungültig",
+			MessageAuthRequired:         "Authentifizierung erforderlich",
+			MessageBodyLimit:            "Der Anfrageinhalt überschreitet die Grenze von {limit} Bytes",
+			MessageRetryLater:           "Anfragelimit überschritten, erneut versuchen in {seconds} Sekunden",
+			MessageWorkspaceRestricted:  "Das Token ist auf den Arbeitsbereich {id} beschränkt",
+			MessageWorkspaceExists:      "Der Arbeitsbereich {id} existiert bereits",
+			MessageWorkspaceOwnerOnly:   "Nur der Eigentümer darf den Arbeitsbereich ändern",
+			MessageListInOtherWorkspace: "Die Liste {id} gehört zu einem anderen Arbeitsbereich",
+			MessageShareLinkIdNotFound:  "Kein Freigabelink mit der ID {id} gefunden",
+			MessageExpiryInPast:         "Das Ablaufdatum muss in der Zukunft liegen",
+			MessageOwnerAsCollaborator:  "Der Eigentümer kann nicht als Mitwirkender hinzugefügt werden",
+			MessageRoleRequired:         "Die Rolle {role} ist erforderlich",
+			MessageAuthScheme:           "Nicht unterstütztes Autorisierungsschema, erwartet wird Bearer",
+			MessageInvalidAPIKey:        "Ungültiger API-Schlüssel",
+			MessageInvalidToken:         "Ungültiges Token: {reason}",
+			MessageTokenSubject:         "Ungültiges Token: das Subject fehlt",
+			MessageCORSOrigin:           "Der Origin {origin} ist nicht erlaubt",
+			MessageCORSMethod:           "Die Methode {method} ist nicht erlaubt",
+			MessageCORSHeader:           "Der Header {header} ist nicht erlaubt",
+			MessageNotAcceptable:        "Nicht akzeptabel, unterstützte Medientypen: {types}",
+			MessageContentType:          "Nicht unterstützter Medientyp {type}, erwartet wird einer von: {types}",
+			MessageImportEmpty:          "Keine Listen im {format}-Dokument gefunden",
+			MessageFormField:            "Das Formularfeld {field} fehlt",
+		},
+		violations: map[string]string{
+			"required":          "ist erforderlich",
+			"max":               "darf höchstens {param} Zeichen lang sein",
+			"max_items":         "darf höchstens {param} Einträge enthalten",
+			"oneof":             "muss einer der folgenden Werte sein: {param}",
+			"lowercase":         "darf nur Kleinbuchstaben enthalten",
+			"alphanum":          "darf nur Buchstaben und Ziffern enthalten",
+			"unique_task_names": "darf keine Aufgaben mit gleichem Namen enthalten",
+			"invalid":           "ist ungültig",
+		},
+	},
+}
+
+/*
+ * Function: Locales
+ * --------------------
+ * Lists the locales messages are available in.
+ *
+ * returns: a sorted slice of locales (e.g. "de", "en")
+ */
+
+func Locales() []string {
+	locales := make([]string, 0, len(catalogs))
+	for locale := range catalogs {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+/*
+ * Function: Localized
+ * --------------------
+ * Instantiates an AppError whose message is taken from the catalogues by key, so that it can be translated when
+ * written (see Problem.Localize). The message of the returned error is the one of the default locale.
+ *
+ * newError: the constructor of the error, e.g. NewNotFoundError
+ * key: the key of the message, one of the Message constants
+ * params: pairs of placeholder names and values to be filled into the message, e.g. "id", "42"
+ *
+ * returns: a pointer to an AppError.
+ */
+
+func Localized(newError func(message string) *AppError, key string, params ...string) *AppError {
+	appError := newError(format(catalogs[DefaultLocale].details[key], params...))
+	appError.messageKey = key
+	appError.params = params
+	return appError
+}
+
+/*
+ * Function: format
+ * --------------------
+ * Fills placeholders of the form {name} in a message template.
+ *
+ * template: the message template
+ * params: pairs of placeholder names and values
+ *
+ * returns: the message
+ */
+
+func format(template string, params ...string) string {
+	for i := 0; i+1 < len(params); i += 2 {
+		template = strings.ReplaceAll(template, "{"+params[i]+"}", params[i+1])
+	}
+	return template
+}
@@ -9,24 +9,26 @@ package errs
 import "net/http"
 
 type AppError struct {
-	Code    int    `json:",omitempty"`
-	Message string `json:"message"`
+	Code       int
+	ErrorCode  string
+	Message    string
+	messageKey string
+	params     []string
 }
 
 /*
- * Method: AppError.AsMessage
+ * Method: AppError.AsProblem
  * --------------------
- * Instantiates an AppError with the Message of the provided AppError
- * and the Code being reset to the zero value. This is needed for
- * serialization.
+ * Converts the AppError into a Problem for serialization.
  *
- * returns: a pointer to an AppError with a zero value (int 0) code.
+ * returns: a pointer to a Problem with the Code as status and the Message as detail.
  */
 
-func (appError AppError) AsMessage() *AppError {
-	return &AppError{
-		Message: appError.Message,
-	}
+func (appError AppError) AsProblem() *Problem {
+	problem := NewProblem(appError.Code, appError.ErrorCode, appError.Message)
+	problem.messageKey = appError.messageKey
+	problem.params = appError.params
+	return problem
 }
 
 /*
@@ -41,8 +43,9 @@ func (appError AppError) AsMessage() *AppError {
 
 func NewNotFoundError(message string) *AppError {
 	return &AppError{
-		Message: message,
-		Code:    http.StatusNotFound,
+		Message:   message,
+		Code:      http.StatusNotFound,
+		ErrorCode: CodeNotFound,
 	}
 }
 
@@ -58,8 +61,9 @@ func NewNotFoundError(message string) *AppError {
 
 func NewInternalError(message string) *AppError {
 	return &AppError{
-		Message: message,
-		Code:    http.StatusInternalServerError,
+		Message:   message,
+		Code:      http.StatusInternalServerError,
+		ErrorCode: CodeInternal,
 	}
 }
 
@@ -75,35 +79,301 @@ func NewInternalError(message string) *AppError {
 
 func NewBadRequestError(message string) *AppError {
 	return &AppError{
-		Message: message,
-		Code:    http.StatusBadRequest,
+		Message:   message,
+		Code:      http.StatusBadRequest,
+		ErrorCode: CodeBadRequest,
+	}
+}
+
+/*
+ * Function: NewUnauthorizedError
+ * --------------------
+ * Instantiates an AppError with the provided message and code 401.
+ *
+ * message: a string providing information on the error.
+ *
+ * returns: a pointer to an AppError.
+ */
+
+func NewUnauthorizedError(message string) *AppError {
+	return &AppError{
+		Message:   message,
+		Code:      http.StatusUnauthorized,
+		ErrorCode: CodeUnauthorized,
+	}
+}
+
+/*
+ * Function: NewForbiddenError
+ * --------------------
+ * Instantiates an AppError with the provided message and code 403.
+ *
+ * message: a string providing information on the error.
+ *
+ * returns: a pointer to an AppError.
+ */
+
+func NewForbiddenError(message string) *AppError {
+	return &AppError{
+		Message:   message,
+		Code:      http.StatusForbidden,
+		ErrorCode: CodeForbidden,
+	}
+}
+
+/*
+ * Function: NewMethodNotAllowedError
+ * --------------------
+ * Instantiates an AppError with the provided message and code 405.
+ *
+ * message: a string providing information on the error.
+ *
+ * returns: a pointer to an AppError.
+ */
+
+func NewMethodNotAllowedError(message string) *AppError {
+	return &AppError{
+		Message:   message,
+		Code:      http.StatusMethodNotAllowed,
+		ErrorCode: CodeMethodNotAllowed,
+	}
+}
+
+/*
+ * Function: NewNotAcceptableError
+ * --------------------
+ * Instantiates an AppError with the provided message and code 406.
+ *
+ * message: a string providing information on the error.
+ *
+ * returns: a pointer to an AppError.
+ */
+
+func NewNotAcceptableError(message string) *AppError {
+	return &AppError{
+		Message:   message,
+		Code:      http.StatusNotAcceptable,
+		ErrorCode: CodeNotAcceptable,
+	}
+}
+
+/*
+ * Function: NewConflictError
+ * --------------------
+ * Instantiates an AppError with the provided message and code 409.
+ *
+ * message: a string providing information on the error.
+ *
+ * returns: a pointer to an AppError.
+ */
+
+func NewConflictError(message string) *AppError {
+	return &AppError{
+		Message:   message,
+		Code:      http.StatusConflict,
+		ErrorCode: CodeConflict,
+	}
+}
+
+/*
+ * Function: NewRequestEntityTooLargeError
+ * --------------------
+ * Instantiates an AppError with the provided message and code 413.
+ *
+ * message: a string providing information on the error.
+ *
+ * returns: a pointer to an AppError.
+ */
+
+func NewRequestEntityTooLargeError(message string) *AppError {
+	return &AppError{
+		Message:   message,
+		Code:      http.StatusRequestEntityTooLarge,
+		ErrorCode: CodeBodyTooLarge,
+	}
+}
+
+/*
+ * Function: NewUnsupportedMediaTypeError
+ * --------------------
+ * Instantiates an AppError with the provided message and code 415.
+ *
+ * message: a string providing information on the error.
+ *
+ * returns: a pointer to an AppError.
+ */
+
+func NewUnsupportedMediaTypeError(message string) *AppError {
+	return &AppError{
+		Message:   message,
+		Code:      http.StatusUnsupportedMediaType,
+		ErrorCode: CodeUnsupportedMediaType,
+	}
+}
+
+/*
+ * Function: NewTooManyRequestsError
+ * --------------------
+ * Instantiates an AppError with the provided message and code 429.
+ *
+ * message: a string providing information on the error.
+ *
+ * returns: a pointer to an AppError.
+ */
+
+func NewTooManyRequestsError(message string) *AppError {
+	return &AppError{
+		Message:   message,
+		Code:      http.StatusTooManyRequests,
+		ErrorCode: CodeRateLimited,
 	}
 }
 
 type ValidationError struct {
-	Code          int               `json:",omitempty"`
-	InvalidFields map[string]string `json:"invalid_fields"`
+	Code          int
+	InvalidFields map[string]string
+	Violations    []FieldViolation
 }
 
 /*
  * Function: NewValidationError
  * --------------------
- * Instantiates a Validation error with the provided field info and code 400.
+ * Instantiates a Validation error with the provided violations and code 400. InvalidFields maps every invalid field
+ * to the name of the rule it failed.
  *
- * invalidFields: a map with field names and violation information.
+ * violations: a slice of FieldViolation, one per invalid field.
  *
  * returns: a pointer to a ValidationError.
  */
 
-func NewValidationError(invalidFields map[string]string) *ValidationError {
+func NewValidationError(violations []FieldViolation) *ValidationError {
+	invalidFields := make(map[string]string, len(violations))
+	for _, violation := range violations {
+		invalidFields[violation.Field] = violation.Rule
+	}
+
 	return &ValidationError{
 		InvalidFields: invalidFields,
+		Violations:    violations,
 		Code:          http.StatusBadRequest,
 	}
 }
 
-func (validationError ValidationError) AsMessage() *ValidationError {
-	return &ValidationError{
-		InvalidFields: validationError.InvalidFields,
+/*
+ * Method: ValidationError.AsProblem
+ * --------------------
+ * Converts the ValidationError into a Problem for serialization.
+ *
+ * returns: a pointer to a Problem with error code validation_failed and the invalid fields and violations as
+ *          extensions.
+ */
+
+func (validationError ValidationError) AsProblem() *Problem {
+	problem := NewProblem(validationError.Code, CodeValidationFailed, "The request contains invalid fields")
+	problem.InvalidFields = validationError.InvalidFields
+	problem.Violations = validationError.Violations
+	return problem
+}
+
+type BodyError struct {
+	AppError
+	Field    string
+	Offset   int64
+	Expected string
+}
+
+/*
+ * Function: NewBodyError
+ * --------------------
+ * Instantiates a BodyError describing why a request body could not be decoded, with code 400.
+ *
+ * message: a string providing information on the error.
+ * field: the path of the offending field (e.g. "tasks.name"), or the empty string if unknown.
+ * offset: the byte offset in the body the error was detected at, or 0 if unknown.
+ * expected: the type expected for the field (e.g. "string"), or the empty string if not applicable.
+ *
+ * returns: a pointer to a BodyError.
+ */
+
+func NewBodyError(message string, field string, offset int64, expected string) *BodyError {
+	return &BodyError{
+		AppError: AppError{
+			Message:   message,
+			Code:      http.StatusBadRequest,
+			ErrorCode: CodeMalformedBody,
+		},
+		Field:    field,
+		Offset:   offset,
+		Expected: expected,
 	}
 }
+
+/*
+ * Method: BodyError.AsProblem
+ * --------------------
+ * Converts the BodyError into a Problem for serialization.
+ *
+ * returns: a pointer to a Problem with field, offset and expected type as extensions.
+ */
+
+func (bodyError BodyError) AsProblem() *Problem {
+	problem := bodyError.AppError.AsProblem()
+	problem.Field = bodyError.Field
+	problem.Offset = bodyError.Offset
+	problem.Expected = bodyError.Expected
+	return problem
+}
+
+type LineError struct {
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
+
+type InvalidList struct {
+	Line          int               `json:"line"`
+	Name          string            `json:"name"`
+	InvalidFields map[string]string `json:"invalid_fields"`
+	Violations    []FieldViolation  `json:"violations,omitempty"`
+}
+
+type ImportError struct {
+	Code         int
+	Message      string
+	ParseErrors  []LineError
+	InvalidLists []InvalidList
+}
+
+/*
+ * Function: NewImportError
+ * --------------------
+ * Instantiates an ImportError with the provided parse and validation errors and code 400.
+ *
+ * parseErrors: a slice of LineError describing input lines that could not be parsed.
+ * invalidLists: a slice of InvalidList describing parsed lists that failed validation.
+ *
+ * returns: a pointer to an ImportError.
+ */
+
+func NewImportError(parseErrors []LineError, invalidLists []InvalidList) *ImportError {
+	return &ImportError{
+		Message:      "Import failed, no lists were saved",
+		ParseErrors:  parseErrors,
+		InvalidLists: invalidLists,
+		Code:         http.StatusBadRequest,
+	}
+}
+
+/*
+ * Method: ImportError.AsProblem
+ * --------------------
+ * Converts the ImportError into a Problem for serialization.
+ *
+ * returns: a pointer to a Problem with error code import_failed and the parse and validation errors as extensions.
+ */
+
+func (importError ImportError) AsProblem() *Problem {
+	problem := NewProblem(importError.Code, CodeImportFailed, importError.Message)
+	problem.ParseErrors = importError.ParseErrors
+	problem.InvalidLists = importError.InvalidLists
+	return problem
+}
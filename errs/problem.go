@@ -0,0 +1,154 @@
+/*
+ * package: errs
+ * --------------------
+ * Includes definitions of types representing custom errors.
+ */
+
+package errs
+
+import "net/http"
+
+const ProblemTypePrefix = "urn:todolistapi:problem:"
+
+const (
+	CodeBadRequest           = "bad_request"
+	CodeMalformedBody        = "malformed_body"
+	CodeValidationFailed     = "validation_failed"
+	CodeImportFailed         = "import_failed"
+	CodeUnauthorized         = "unauthorized"
+	CodeForbidden            = "forbidden"
+	CodeNotFound             = "not_found"
+	CodeMethodNotAllowed     = "method_not_allowed"
+	CodeNotAcceptable        = "not_acceptable"
+	CodeConflict             = "conflict"
+	CodeBodyTooLarge         = "body_too_large"
+	CodeUnsupportedMediaType = "unsupported_media_type"
+	CodeRateLimited          = "rate_limited"
+	CodeInternal             = "internal_error"
+)
+
+var problemTitles = map[string]string{
+	CodeBadRequest:           "Bad request",
+	CodeMalformedBody:        "Malformed request body",
+	CodeValidationFailed:     "Validation failed",
+	CodeImportFailed:         "Import failed",
+	CodeUnauthorized:         "Authentication required",
+	CodeForbidden:            "Permission denied",
+	CodeNotFound:             "Resource not found",
+	CodeMethodNotAllowed:     "Method not allowed",
+	CodeNotAcceptable:        "Media type not acceptable",
+	CodeConflict:             "Resource already exists",
+	CodeBodyTooLarge:         "Request body too large",
+	CodeUnsupportedMediaType: "Unsupported media type",
+	CodeRateLimited:          "Rate limit exceeded",
+	CodeInternal:             "Internal server error",
+}
+
+type Problem struct {
+	Type          string            `json:"type"`
+	Title         string            `json:"title"`
+	Status        int               `json:"status"`
+	Detail        string            `json:"detail,omitempty"`
+	Instance      string            `json:"instance,omitempty"`
+	ErrorCode     string            `json:"code"`
+	InvalidFields map[string]string `json:"invalid_fields,omitempty"`
+	Violations    []FieldViolation  `json:"violations,omitempty"`
+	Field         string            `json:"field,omitempty"`
+	Offset        int64             `json:"offset,omitempty"`
+	Expected      string            `json:"expected,omitempty"`
+	ParseErrors   []LineError       `json:"parse_errors,omitempty"`
+	InvalidLists  []InvalidList     `json:"invalid_lists,omitempty"`
+	messageKey    string
+	params        []string
+}
+
+/*
+ * Function: NewProblem
+ * --------------------
+ * Instantiates a Problem (RFC 7807 problem details). Type and title are derived from the error code, so that both
+ * are the same for every occurrence of a problem.
+ *
+ * status: the http status code.
+ * errorCode: the stable, machine-readable error code (one of the Code constants).
+ * detail: a string describing this occurrence of the problem.
+ *
+ * returns: a pointer to a Problem.
+ */
+
+func NewProblem(status int, errorCode string, detail string) *Problem {
+	title, ok := problemTitles[errorCode]
+	if !ok {
+		title = http.StatusText(status)
+	}
+	return &Problem{
+		Type:      ProblemTypePrefix + errorCode,
+		Title:     title,
+		Status:    status,
+		Detail:    detail,
+		ErrorCode: errorCode,
+	}
+}
+
+/*
+ * Method: Problem.Localize
+ * --------------------
+ * Translates title, detail and violation messages of the Problem into a locale. The detail is taken from the
+ * catalogue by the message key of the error (see Localized) and falls back to a generic detail for the error code,
+ * since details without key are only available in the default locale. Unsupported locales leave the Problem as is.
+ * Modifies the Problem it is applied to (pointer receiver).
+ *
+ * locale: one of the locales returned by Locales
+ *
+ * returns: nothing
+ */
+
+func (problem *Problem) Localize(locale string) {
+	c, ok := catalogs[locale]
+	if !ok || locale == DefaultLocale {
+		return
+	}
+
+	if title, ok := c.titles[problem.ErrorCode]; ok {
+		problem.Title = title
+	}
+	if detail, ok := c.details[problem.messageKey]; ok && problem.messageKey != "" {
+		problem.Detail = format(detail, problem.params...)
+	} else if detail, ok := c.details[problem.ErrorCode]; ok {
+		problem.Detail = detail
+	}
+
+	problem.Violations = localizeViolations(c, problem.Violations)
+	if problem.InvalidLists != nil {
+		invalidLists := make([]InvalidList, len(problem.InvalidLists))
+		for i, invalidList := range problem.InvalidLists {
+			invalidList.Violations = localizeViolations(c, invalidList.Violations)
+			invalidLists[i] = invalidList
+		}
+		problem.InvalidLists = invalidLists
+	}
+}
+
+/*
+ * Function: localizeViolations
+ * --------------------
+ * Translates the messages of violations. The violations are copied, so that the slice of the error the Problem was
+ * created from is left unchanged.
+ *
+ * c: the catalog to take the messages from
+ * violations: a slice of FieldViolation
+ *
+ * returns: a slice of translated FieldViolation, or nil if there are none
+ */
+
+func localizeViolations(c catalog, violations []FieldViolation) []FieldViolation {
+	if violations == nil {
+		return nil
+	}
+
+	localized := make([]FieldViolation, len(violations))
+	for i, violation := range violations {
+		violation.Message = violation.messageIn(c)
+		localized[i] = violation
+	}
+	return localized
+}
@@ -0,0 +1,69 @@
+/*
+ * package: errs
+ * --------------------
+ * Includes definitions of types representing custom errors.
+ */
+
+package errs
+
+import (
+	"strings"
+)
+
+type FieldViolation struct {
+	Field   string      `json:"field"`
+	Rule    string      `json:"rule"`
+	Param   string      `json:"param,omitempty"`
+	Value   interface{} `json:"value,omitempty"`
+	Message string      `json:"message"`
+	key     string
+}
+
+/*
+ * Function: NewFieldViolation
+ * --------------------
+ * Instantiates a FieldViolation describing one rule a field failed. The message is taken from the catalogues by
+ * rule, so that it is the same for every occurrence of a violation and can be translated (see Problem.Localize).
+ *
+ * field: the path of the invalid field (e.g. "tasks[0].name").
+ * rule: the name of the failed rule (e.g. "max").
+ * param: the parameter of the rule (e.g. "200"), or the empty string if it has none.
+ * value: the rejected value, or nil if it is not safe to be echoed.
+ * collection: whether the field is a slice or map, whose size rather than length is constrained.
+ *
+ * returns: a FieldViolation.
+ */
+
+func NewFieldViolation(field string, rule string, param string, value interface{}, collection bool) FieldViolation {
+	key := rule
+	if collection && rule == "max" {
+		key = "max_items"
+	}
+	if _, ok := catalogs[DefaultLocale].violations[key]; !ok {
+		key = "invalid"
+	}
+
+	violation := FieldViolation{
+		Field: field,
+		Rule:  rule,
+		Param: param,
+		Value: value,
+		key:   key,
+	}
+	violation.Message = violation.messageIn(catalogs[DefaultLocale])
+	return violation
+}
+
+/*
+ * Method: FieldViolation.messageIn
+ * --------------------
+ * Renders the message of the FieldViolation from a catalogue.
+ *
+ * c: the catalog to take the message template from
+ *
+ * returns: the message
+ */
+
+func (violation FieldViolation) messageIn(c catalog) string {
+	return format(c.violations[violation.key], "param", strings.ReplaceAll(violation.Param, " ", ", "))
+}
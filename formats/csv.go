@@ -0,0 +1,192 @@
+/*
+ * package: formats
+ * --------------------
+ * Includes renderers and parsers for exporting and importing domain.ToDoList values in plain text formats
+ * (CSV, Markdown, todo.txt) as well as iCalendar feeds.
+ */
+
+package formats
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var csvHeader = []string{
+	"list_id", "list_name", "list_description", "task_id", "task_name", "task_description", "task_done", "task_due",
+}
+
+/*
+ * Function: renderCSV
+ * --------------------
+ * Renders lists as CSV with a header row and one row per task. List columns are repeated for every task.
+ *
+ * w: the io.Writer to render into.
+ * lists: a slice of domain.ToDoList to be rendered.
+ *
+ * returns: an error if writing fails, nil otherwise.
+ */
+
+func renderCSV(w io.Writer, lists []domain.ToDoList) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, list := range lists {
+		for _, task := range list.Tasks {
+			record := []string{
+				list.Id.Hex(),
+				list.Name,
+				valueOrEmpty(list.Description),
+				task.Id,
+				task.Name,
+				valueOrEmpty(task.Description),
+				strconv.FormatBool(task.Done),
+				formatDue(task.Due),
+			}
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+/*
+ * Function: parseCSV
+ * --------------------
+ * Parses CSV with a header row naming the columns (see csvHeader; the order is irrelevant and only list_name and
+ * task_name are required). Rows are grouped into lists by list_id or, if that column is absent or empty, by
+ * list_name. The list description is taken from the first row of a list. Task ids are ignored. task_done accepts
+ * the values understood by strconv.ParseBool (empty means false), task_due dates as YYYY-MM-DD or RFC 3339.
+ *
+ * r: the io.Reader to parse from.
+ *
+ * returns: a slice of ParsedList and a slice of errs.LineError.
+ */
+
+func parseCSV(r io.Reader) ([]ParsedList, []errs.LineError) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, []errs.LineError{csvLineError(err, 1)}
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	for _, required := range []string{"list_name", "task_name"} {
+		if _, ok := columns[required]; !ok {
+			return nil, []errs.LineError{{Line: 1, Message: "Missing column " + required}}
+		}
+	}
+
+	field := func(record []string, column string) string {
+		if i, ok := columns[column]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var parsed []ParsedList
+	var lineErrors []errs.LineError
+	listIndex := make(map[string]int)
+	line := 1
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			lineError := csvLineError(err, line+1)
+			lineErrors = append(lineErrors, lineError)
+			if !errors.As(err, new(*csv.ParseError)) {
+				break
+			}
+			line = lineError.Line
+			continue
+		}
+		line, _ = reader.FieldPos(0)
+		if len(record) < len(header) {
+			lineErrors = append(lineErrors, errs.LineError{
+				Line:    line,
+				Message: fmt.Sprintf("Expected %d fields, got %d", len(header), len(record)),
+			})
+			continue
+		}
+
+		listName := field(record, "list_name")
+		key := field(record, "list_id")
+		if key == "" {
+			key = "name:" + listName
+		}
+
+		i, ok := listIndex[key]
+		if !ok {
+			i = len(parsed)
+			listIndex[key] = i
+			parsed = append(parsed, ParsedList{
+				List: domain.ToDoList{
+					Name:        listName,
+					Description: optional(field(record, "list_description")),
+					Tasks:       []domain.Task{},
+				},
+				Line: line,
+			})
+		}
+
+		task := domain.Task{
+			Name:        field(record, "task_name"),
+			Description: optional(field(record, "task_description")),
+		}
+		if done := field(record, "task_done"); done != "" {
+			if task.Done, err = strconv.ParseBool(done); err != nil {
+				lineErrors = append(lineErrors, errs.LineError{Line: line, Message: "Invalid task_done value " + strconv.Quote(done)})
+			}
+		}
+		if task.Due, err = parseDue(field(record, "task_due")); err != nil {
+			lineErrors = append(lineErrors, errs.LineError{Line: line, Message: err.Error()})
+		}
+
+		parsed[i].List.Tasks = append(parsed[i].List.Tasks, task)
+	}
+
+	return parsed, lineErrors
+}
+
+/*
+ * Function: csvLineError
+ * --------------------
+ * Converts an error returned by csv.Reader into an errs.LineError. Parse errors carry the line the failed record
+ * starts on (csv.ParseError.StartLine), as csv.Reader.FieldPos must not be called after a failed read.
+ *
+ * err: the error returned by the reader.
+ * line: the line to be reported if err does not carry line information (errors of the underlying io.Reader).
+ *
+ * returns: an errs.LineError.
+ */
+
+func csvLineError(err error, line int) errs.LineError {
+	var parseError *csv.ParseError
+	if errors.As(err, &parseError) {
+		return errs.LineError{Line: parseError.StartLine, Message: parseError.Err.Error()}
+	}
+	return errs.LineError{Line: line, Message: err.Error()}
+}
@@ -0,0 +1,260 @@
+/*
+ * package: formats
+ * --------------------
+ * Includes renderers and parsers for exporting and importing domain.ToDoList values in plain text formats
+ * (CSV, Markdown, todo.txt) as well as iCalendar feeds.
+ */
+
+package formats
+
+import (
+	"fmt"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"io"
+	"mime"
+	"sort"
+	"strings"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+type Format struct {
+	Name        string
+	ContentType string
+	Extension   string
+	render      func(io.Writer, []domain.ToDoList) error
+	parse       func(io.Reader) ([]ParsedList, []errs.LineError)
+}
+
+type ParsedList struct {
+	List domain.ToDoList
+	Line int
+}
+
+var registry = map[string]Format{
+	"csv": {
+		Name:        "csv",
+		ContentType: "text/csv; charset=utf-8",
+		Extension:   "csv",
+		render:      renderCSV,
+		parse:       parseCSV,
+	},
+	"markdown": {
+		Name:        "markdown",
+		ContentType: "text/markdown; charset=utf-8",
+		Extension:   "md",
+		render:      renderMarkdown,
+		parse:       parseMarkdown,
+	},
+	"todotxt": {
+		Name:        "todotxt",
+		ContentType: "text/plain; charset=utf-8",
+		Extension:   "txt",
+		render:      renderTodoTxt,
+		parse:       parseTodoTxt,
+	},
+}
+
+/*
+ * Function: Lookup
+ * --------------------
+ * Retrieves the Format registered under the provided name.
+ *
+ * name: the name of the format, e.g. "csv", "markdown" or "todotxt".
+ *
+ * returns: the matching Format and true if the name is known. Otherwise, the zero value and false.
+ */
+
+func Lookup(name string) (Format, bool) {
+	format, ok := registry[name]
+	return format, ok
+}
+
+/*
+ * Function: LookupByContentType
+ * --------------------
+ * Retrieves the Format whose content type matches the provided media type. Parameters such as the charset are
+ * ignored. Besides the canonical content types, "text/x-markdown" is accepted for markdown.
+ *
+ * contentType: the value of a Content-Type header.
+ *
+ * returns: the matching Format and true if the media type is known. Otherwise, the zero value and false.
+ */
+
+func LookupByContentType(contentType string) (Format, bool) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return Format{}, false
+	}
+	if mediaType == "text/x-markdown" {
+		return Lookup("markdown")
+	}
+	for _, format := range registry {
+		if formatMediaType, _, _ := mime.ParseMediaType(format.ContentType); formatMediaType == mediaType {
+			return format, true
+		}
+	}
+	return Format{}, false
+}
+
+/*
+ * Function: LookupByFileName
+ * --------------------
+ * Retrieves the Format matching the extension of the provided file name (case-insensitive).
+ *
+ * fileName: the name of an uploaded file, e.g. "lists.csv".
+ *
+ * returns: the matching Format and true if the extension is known. Otherwise, the zero value and false.
+ */
+
+func LookupByFileName(fileName string) (Format, bool) {
+	i := strings.LastIndex(fileName, ".")
+	if i < 0 {
+		return Format{}, false
+	}
+	extension := strings.ToLower(fileName[i+1:])
+	if extension == "markdown" {
+		return Lookup("markdown")
+	}
+	for _, format := range registry {
+		if format.Extension == extension {
+			return format, true
+		}
+	}
+	return Format{}, false
+}
+
+/*
+ * Function: Names
+ * --------------------
+ * Lists the names of all registered formats in alphabetical order.
+ *
+ * returns: a slice of format names.
+ */
+
+func Names() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+/*
+ * Method: Format.Render
+ * --------------------
+ * Writes the provided lists and their tasks to w in the respective format.
+ *
+ * w: the io.Writer to render into.
+ * lists: a slice of domain.ToDoList to be rendered.
+ *
+ * returns: an error if writing fails, nil otherwise.
+ */
+
+func (format Format) Render(w io.Writer, lists []domain.ToDoList) error {
+	return format.render(w, lists)
+}
+
+/*
+ * Method: Format.Parse
+ * --------------------
+ * Reads lists and their tasks in the respective format from r. Parsing continues after erroneous lines, so that
+ * all problems of an input can be reported at once. Parsed lists are not validated.
+ *
+ * r: the io.Reader to parse from.
+ *
+ * returns: a slice of ParsedList (each with the line it starts on) and a slice of errs.LineError, which is empty
+ *          if the whole input could be parsed.
+ */
+
+func (format Format) Parse(r io.Reader) ([]ParsedList, []errs.LineError) {
+	return format.parse(r)
+}
+
+/*
+ * Function: valueOrEmpty
+ * --------------------
+ * Dereferences an optional string.
+ *
+ * s: a pointer to a string, possibly nil.
+ *
+ * returns: the string pointed to or an empty string if s is nil.
+ */
+
+func valueOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+/*
+ * Function: optional
+ * --------------------
+ * Converts a string into an optional string, treating blank strings as absent.
+ *
+ * s: the string to be converted.
+ *
+ * returns: a pointer to the trimmed string or nil if s is blank.
+ */
+
+func optional(s string) *string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+/*
+ * Function: formatDue
+ * --------------------
+ * Formats an optional due date. Due dates at midnight UTC are written as date only (YYYY-MM-DD), all others as
+ * RFC 3339 timestamps.
+ *
+ * due: a pointer to a time.Time, possibly nil.
+ *
+ * returns: the formatted due date or an empty string if due is nil.
+ */
+
+func formatDue(due *time.Time) string {
+	if due == nil {
+		return ""
+	}
+	utc := due.UTC()
+	if utc.Equal(utc.Truncate(24 * time.Hour)) {
+		return utc.Format(dateLayout)
+	}
+	return utc.Format(time.RFC3339)
+}
+
+/*
+ * Function: parseDue
+ * --------------------
+ * Parses an optional due date written either as date only (YYYY-MM-DD, interpreted as midnight UTC) or as RFC 3339
+ * timestamp.
+ *
+ * s: the string to be parsed.
+ *
+ * returns: a pointer to the parsed time.Time (nil if s is blank) and nil on success.
+ *          Otherwise, nil and an error are returned.
+ */
+
+func parseDue(s string) (*time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	due, err := time.Parse(dateLayout, s)
+	if err != nil {
+		due, err = time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid due date %q, expected YYYY-MM-DD or RFC 3339", s)
+		}
+	}
+	due = due.UTC()
+	return &due, nil
+}
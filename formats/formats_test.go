@@ -0,0 +1,308 @@
+/*
+ * package: formats
+ * --------------------
+ * Includes renderers and parsers for exporting and importing domain.ToDoList values in plain text formats
+ * (CSV, Markdown, todo.txt) as well as iCalendar feeds.
+ */
+
+package formats
+
+import (
+	"bufio"
+	"bytes"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/testUtils/dummies"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+/*
+ * function: renderDummy
+ * --------------------
+ * Renders the provided lists in the named format.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ * name: the name of the format.
+ * lists: the lists to be rendered.
+ *
+ * Returns: the rendered output as a string.
+ */
+
+func renderDummy(t *testing.T, name string, lists []domain.ToDoList) string {
+	format, ok := Lookup(name)
+	if !ok {
+		t.Fatalf("Format %s not registered", name)
+	}
+	var buffer bytes.Buffer
+	if err := format.Render(&buffer, lists); err != nil {
+		t.Fatalf("Expected nil, got error %v instead", err)
+	}
+	return buffer.String()
+}
+
+/*
+ * function: Test_Lookup_should_return_false_for_unknown_format
+ * --------------------
+ * Tests if Lookup reports unknown format names.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Lookup_should_return_false_for_unknown_format(t *testing.T) {
+	if _, ok := Lookup("pdf"); ok {
+		t.Error("Expected false, got true instead")
+	}
+}
+
+/*
+ * function: Test_Format_Render_should_render_csv_with_one_row_per_task
+ * --------------------
+ * Tests if the csv format renders a header row and one row per task.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Format_Render_should_render_csv_with_one_row_per_task(t *testing.T) {
+	output := renderDummy(t, "csv", []domain.ToDoList{dummies.DummyListValidWithIds})
+
+	if output != dummies.DummyListValidWithIdsAsCSV {
+		t.Errorf("Output does not match, got %q", output)
+	}
+}
+
+/*
+ * function: Test_Format_Render_should_render_markdown_checklist
+ * --------------------
+ * Tests if the markdown format renders a heading per list and a checklist item per task.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Format_Render_should_render_markdown_checklist(t *testing.T) {
+	output := renderDummy(t, "markdown", []domain.ToDoList{dummies.DummyListValidWithIds})
+
+	if output != dummies.DummyListValidWithIdsAsMarkdown {
+		t.Errorf("Output does not match, got %q", output)
+	}
+}
+
+/*
+ * function: Test_Format_Render_should_render_todotxt_line_per_task
+ * --------------------
+ * Tests if the todotxt format renders one line per task tagged with the list name as project.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Format_Render_should_render_todotxt_line_per_task(t *testing.T) {
+	output := renderDummy(t, "todotxt", []domain.ToDoList{dummies.DummyListValidWithIds})
+
+	if output != dummies.DummyListValidWithIdsAsTodoTxt {
+		t.Errorf("Output does not match, got %q", output)
+	}
+}
+
+/*
+ * function: Test_Format_Parse_should_group_csv_rows_into_lists
+ * --------------------
+ * Tests if the csv format groups rows by list and reports rows with missing fields and rows that cannot be parsed
+ * (e.g. a quote within an unquoted first field) by line.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Format_Parse_should_group_csv_rows_into_lists(t *testing.T) {
+	format, _ := Lookup("csv")
+	input := "list_name,task_name,task_description\nGroceries,Milk,\nChores,Laundry,Whites only\nGroceries,Bread,\nBroken\n"
+
+	parsed, lineErrors := format.Parse(strings.NewReader(input))
+
+	if len(parsed) != 2 {
+		t.Fatalf("Expected 2 lists, got %v instead", len(parsed))
+	}
+	if parsed[0].List.Name != "Groceries" || len(parsed[0].List.Tasks) != 2 || parsed[0].Line != 2 {
+		t.Errorf("First list does not match, got %+v", parsed[0])
+	}
+	if description := parsed[1].List.Tasks[0].Description; description == nil || *description != "Whites only" {
+		t.Error("Task description does not match")
+	}
+	if len(lineErrors) != 1 || lineErrors[0].Line != 5 {
+		t.Errorf("Expected one error on line 5, got %+v instead", lineErrors)
+	}
+
+	parsed, lineErrors = format.Parse(strings.NewReader("list_name,task_name\na\"b,c\nGroceries,Milk\n"))
+
+	if len(parsed) != 1 || parsed[0].Line != 3 {
+		t.Errorf("Expected the list on line 3, got %+v instead", parsed)
+	}
+	if len(lineErrors) != 1 || lineErrors[0].Line != 2 {
+		t.Errorf("Expected one error on line 2, got %+v instead", lineErrors)
+	}
+}
+
+/*
+ * function: Test_Format_Parse_should_read_rendered_markdown
+ * --------------------
+ * Tests if the markdown format parses headings, descriptions and checklist items including indented task
+ * descriptions.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Format_Parse_should_read_rendered_markdown(t *testing.T) {
+	format, _ := Lookup("markdown")
+	input := "# Groceries\n\nFor the weekend\n\n- [ ] Milk\n  Oat milk\n- [x] Bread (due: 2021-02-05)\n\n## Chores\n* [ ] Laundry\n"
+
+	parsed, lineErrors := format.Parse(strings.NewReader(input))
+
+	if len(lineErrors) != 0 {
+		t.Fatalf("Expected no errors, got %+v instead", lineErrors)
+	}
+	if len(parsed) != 2 {
+		t.Fatalf("Expected 2 lists, got %v instead", len(parsed))
+	}
+	groceries := parsed[0].List
+	if groceries.Name != "Groceries" || groceries.Description == nil || *groceries.Description != "For the weekend" {
+		t.Errorf("First list does not match, got %+v", groceries)
+	}
+	if len(groceries.Tasks) != 2 || groceries.Tasks[0].Description == nil || *groceries.Tasks[0].Description != "Oat milk" {
+		t.Errorf("Tasks do not match, got %+v", groceries.Tasks)
+	}
+	bread := groceries.Tasks[1]
+	if bread.Name != "Bread" || !bread.Done || bread.Due == nil || formatDue(bread.Due) != "2021-02-05" {
+		t.Errorf("Done task does not match, got %+v", bread)
+	}
+	if parsed[1].List.Name != "Chores" || parsed[1].Line != 9 {
+		t.Errorf("Second list does not match, got %+v", parsed[1])
+	}
+}
+
+/*
+ * function: Test_Format_Parse_should_report_markdown_items_outside_of_lists
+ * --------------------
+ * Tests if the markdown format reports checklist items before the first heading and plain list items.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Format_Parse_should_report_markdown_items_outside_of_lists(t *testing.T) {
+	format, _ := Lookup("markdown")
+	input := "- [ ] Orphan\n# List\n- Not a checklist item\n"
+
+	_, lineErrors := format.Parse(strings.NewReader(input))
+
+	if len(lineErrors) != 2 || lineErrors[0].Line != 1 || lineErrors[1].Line != 3 {
+		t.Errorf("Expected errors on lines 1 and 3, got %+v instead", lineErrors)
+	}
+}
+
+/*
+ * function: Test_Format_Parse_should_group_todotxt_lines_by_project
+ * --------------------
+ * Tests if the todotxt format strips completion markers, priorities and dates and groups tasks by project.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Format_Parse_should_group_todotxt_lines_by_project(t *testing.T) {
+	format, _ := Lookup("todotxt")
+	input := "(A) 2021-02-01 Call Mom +Family_Stuff @phone\nx 2021-02-03 2021-02-01 Buy cake +Family_Stuff due:2021-02-05\nWater plants\n"
+
+	parsed, lineErrors := format.Parse(strings.NewReader(input))
+
+	if len(lineErrors) != 0 {
+		t.Fatalf("Expected no errors, got %+v instead", lineErrors)
+	}
+	if len(parsed) != 2 {
+		t.Fatalf("Expected 2 lists, got %v instead", len(parsed))
+	}
+	family := parsed[0].List
+	if family.Name != "Family Stuff" || len(family.Tasks) != 2 {
+		t.Errorf("First list does not match, got %+v", family)
+	}
+	if family.Tasks[0].Name != "Call Mom @phone" || family.Tasks[1].Name != "Buy cake" {
+		t.Errorf("Task names do not match, got %+v", family.Tasks)
+	}
+	if family.Tasks[0].Done || !family.Tasks[1].Done || family.Tasks[1].Due == nil {
+		t.Errorf("Completion or due date does not match, got %+v", family.Tasks)
+	}
+	if parsed[1].List.Name != "Inbox" || parsed[1].Line != 3 {
+		t.Errorf("Second list does not match, got %+v", parsed[1])
+	}
+}
+
+/*
+ * function: Test_RenderCalendar_should_render_tasks_with_due_date_as_vtodo
+ * --------------------
+ * Tests if RenderCalendar renders only tasks with a due date, escapes text values and derives DTSTAMP from the
+ * list id.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_RenderCalendar_should_render_tasks_with_due_date_as_vtodo(t *testing.T) {
+	var buffer bytes.Buffer
+	if err := RenderCalendar(&buffer, []domain.ToDoList{dummies.DummyListWithDueDates}); err != nil {
+		t.Fatalf("Expected nil, got error %v instead", err)
+	}
+
+	if buffer.String() != dummies.DummyListWithDueDatesAsICalendar {
+		t.Errorf("Output does not match, got %q", buffer.String())
+	}
+}
+
+/*
+ * function: Test_writeCalendarLine_should_fold_lines_longer_than_75_octets
+ * --------------------
+ * Tests if long content lines are folded into continuation lines of at most 75 octets without splitting
+ * multi-byte characters.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_writeCalendarLine_should_fold_lines_longer_than_75_octets(t *testing.T) {
+	var buffer bytes.Buffer
+	writer := bufio.NewWriter(&buffer)
+	line := "SUMMARY:" + strings.Repeat("ä", 100)
+
+	writeCalendarLine(writer, line)
+	_ = writer.Flush()
+
+	physicalLines := strings.Split(strings.TrimSuffix(buffer.String(), "\r\n"), "\r\n")
+	if len(physicalLines) < 3 {
+		t.Fatalf("Expected line to be folded, got %v lines", len(physicalLines))
+	}
+	unfolded := physicalLines[0]
+	for _, physicalLine := range physicalLines {
+		if len(physicalLine) > 75 || !utf8.ValidString(physicalLine) {
+			t.Errorf("Invalid folded line %q", physicalLine)
+		}
+	}
+	for _, physicalLine := range physicalLines[1:] {
+		unfolded += strings.TrimPrefix(physicalLine, " ")
+	}
+	if unfolded != line {
+		t.Error("Unfolded line does not match")
+	}
+}
@@ -0,0 +1,100 @@
+/*
+ * package: formats
+ * --------------------
+ * Includes renderers and parsers for exporting and importing domain.ToDoList values in plain text formats
+ * (CSV, Markdown, todo.txt) as well as iCalendar feeds.
+ */
+
+package formats
+
+import (
+	"bufio"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	CalendarContentType = "text/calendar; charset=utf-8"
+	calendarTimeLayout  = "20060102T150405Z"
+	calendarLineLength  = 75
+)
+
+var calendarEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+/*
+ * Function: RenderCalendar
+ * --------------------
+ * Renders all tasks with a due date as VTODO components of an RFC 5545 calendar. The task id serves as UID and the
+ * list's creation time (taken from its object id) as DTSTAMP, so the output only changes if the lists change and
+ * calendar clients can poll it. Lines are terminated by CRLF and folded after 75 octets.
+ *
+ * w: the io.Writer to render into.
+ * lists: a slice of domain.ToDoList whose tasks are to be rendered.
+ *
+ * returns: an error if writing fails, nil otherwise.
+ */
+
+func RenderCalendar(w io.Writer, lists []domain.ToDoList) error {
+	writer := bufio.NewWriter(w)
+
+	writeCalendarLine(writer, "BEGIN:VCALENDAR")
+	writeCalendarLine(writer, "VERSION:2.0")
+	writeCalendarLine(writer, "PRODID:-//luschnat-ziegler//toDoListAPI//EN")
+	writeCalendarLine(writer, "CALSCALE:GREGORIAN")
+
+	for _, list := range lists {
+		stamp := list.Id.Timestamp().UTC().Format(calendarTimeLayout)
+		for _, task := range list.Tasks {
+			if task.Due == nil {
+				continue
+			}
+			status := "NEEDS-ACTION"
+			if task.Done {
+				status = "COMPLETED"
+			}
+
+			writeCalendarLine(writer, "BEGIN:VTODO")
+			writeCalendarLine(writer, "UID:"+calendarEscaper.Replace(task.Id))
+			writeCalendarLine(writer, "DTSTAMP:"+stamp)
+			writeCalendarLine(writer, "SUMMARY:"+calendarEscaper.Replace(task.Name))
+			if task.Description != nil && *task.Description != "" {
+				writeCalendarLine(writer, "DESCRIPTION:"+calendarEscaper.Replace(*task.Description))
+			}
+			writeCalendarLine(writer, "CATEGORIES:"+calendarEscaper.Replace(list.Name))
+			writeCalendarLine(writer, "DUE:"+task.Due.UTC().Format(calendarTimeLayout))
+			writeCalendarLine(writer, "STATUS:"+status)
+			writeCalendarLine(writer, "END:VTODO")
+		}
+	}
+
+	writeCalendarLine(writer, "END:VCALENDAR")
+	return writer.Flush()
+}
+
+/*
+ * Function: writeCalendarLine
+ * --------------------
+ * Writes a content line terminated by CRLF, folding it into continuation lines (starting with a space) so that no
+ * line exceeds 75 octets. Multi-byte characters are never split.
+ *
+ * writer: the bufio.Writer to write to. Errors are reported by its Flush method.
+ * line: the unfolded content line.
+ *
+ * returns: nothing
+ */
+
+func writeCalendarLine(writer *bufio.Writer, line string) {
+	limit := calendarLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		writer.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+		limit = calendarLineLength - 1
+	}
+	writer.WriteString(line + "\r\n")
+}
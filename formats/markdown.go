@@ -0,0 +1,175 @@
+/*
+ * package: formats
+ * --------------------
+ * Includes renderers and parsers for exporting and importing domain.ToDoList values in plain text formats
+ * (CSV, Markdown, todo.txt) as well as iCalendar feeds.
+ */
+
+package formats
+
+import (
+	"bufio"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"io"
+	"regexp"
+	"strings"
+)
+
+var markdownHeading = regexp.MustCompile(`^#{1,6}\s+(.*?)\s*#*\s*$`)
+var markdownChecklistItem = regexp.MustCompile(`^[-*+]\s+\[([ xX])\]\s*(.*)$`)
+var markdownListItem = regexp.MustCompile(`^[-*+]\s+`)
+var markdownDue = regexp.MustCompile(`\s*\(due:\s*([^)]*)\)$`)
+
+/*
+ * Function: renderMarkdown
+ * --------------------
+ * Renders every list as a level one heading, followed by its description as a paragraph and its tasks as a
+ * GitHub-style checklist, with done tasks checked and due dates appended as "(due: YYYY-MM-DD)". Task descriptions
+ * are written as indented lines below their task.
+ *
+ * w: the io.Writer to render into.
+ * lists: a slice of domain.ToDoList to be rendered.
+ *
+ * returns: an error if writing fails, nil otherwise.
+ */
+
+func renderMarkdown(w io.Writer, lists []domain.ToDoList) error {
+	writer := bufio.NewWriter(w)
+
+	for i, list := range lists {
+		if i > 0 {
+			writer.WriteString("\n")
+		}
+		writer.WriteString("# " + singleLine(list.Name) + "\n\n")
+		if description := valueOrEmpty(list.Description); description != "" {
+			writer.WriteString(description + "\n\n")
+		}
+		for _, task := range list.Tasks {
+			checkbox := "[ ]"
+			if task.Done {
+				checkbox = "[x]"
+			}
+			writer.WriteString("- " + checkbox + " " + singleLine(task.Name))
+			if task.Due != nil {
+				writer.WriteString(" (due: " + formatDue(task.Due) + ")")
+			}
+			writer.WriteString("\n")
+			if description := valueOrEmpty(task.Description); description != "" {
+				for _, line := range strings.Split(description, "\n") {
+					writer.WriteString("  " + line + "\n")
+				}
+			}
+		}
+	}
+
+	return writer.Flush()
+}
+
+/*
+ * Function: parseMarkdown
+ * --------------------
+ * Parses GitHub-style markdown checklists. Every heading starts a new list named after it, paragraphs between a
+ * heading and the first checklist item form the list description, and every checklist item ("- [ ] name" or
+ * "- [x] name" for done tasks, optionally followed by "(due: YYYY-MM-DD)") becomes a task. Indented lines directly
+ * below an item form the task description. Checklist items
+ * outside a list, plain list items and paragraphs after the first task are reported as errors.
+ *
+ * r: the io.Reader to parse from.
+ *
+ * returns: a slice of ParsedList and a slice of errs.LineError.
+ */
+
+func parseMarkdown(r io.Reader) ([]ParsedList, []errs.LineError) {
+	var parsed []ParsedList
+	var lineErrors []errs.LineError
+	var current *ParsedList
+	var descriptionLines []string
+	var task *domain.Task
+	var taskDescriptionLines []string
+
+	finishTask := func() {
+		if task != nil {
+			task.Description = optional(strings.Join(taskDescriptionLines, "\n"))
+			current.List.Tasks = append(current.List.Tasks, *task)
+			task = nil
+			taskDescriptionLines = nil
+		}
+	}
+	finishList := func() {
+		if current != nil {
+			finishTask()
+			current.List.Description = optional(strings.Join(descriptionLines, "\n"))
+			parsed = append(parsed, *current)
+			current = nil
+			descriptionLines = nil
+		}
+	}
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimRight(scanner.Text(), " \t\r")
+
+		if task != nil && text != "" && (strings.HasPrefix(text, "  ") || strings.HasPrefix(text, "\t")) {
+			taskDescriptionLines = append(taskDescriptionLines, strings.TrimSpace(text))
+			continue
+		}
+
+		trimmed := strings.TrimSpace(text)
+		switch {
+		case trimmed == "":
+			continue
+		case markdownHeading.MatchString(trimmed):
+			finishList()
+			name := markdownHeading.FindStringSubmatch(trimmed)[1]
+			current = &ParsedList{List: domain.ToDoList{Name: name, Tasks: []domain.Task{}}, Line: line}
+		case markdownChecklistItem.MatchString(trimmed):
+			if current == nil {
+				lineErrors = append(lineErrors, errs.LineError{Line: line, Message: "Checklist item outside of a list, expected a heading first"})
+				continue
+			}
+			finishTask()
+			match := markdownChecklistItem.FindStringSubmatch(trimmed)
+			task = &domain.Task{Name: strings.TrimSpace(match[2]), Done: match[1] != " "}
+			if dueMatch := markdownDue.FindStringSubmatch(task.Name); dueMatch != nil {
+				due, err := parseDue(dueMatch[1])
+				if err != nil {
+					lineErrors = append(lineErrors, errs.LineError{Line: line, Message: err.Error()})
+				}
+				task.Name = strings.TrimSuffix(task.Name, dueMatch[0])
+				task.Due = due
+			}
+		case markdownListItem.MatchString(trimmed):
+			lineErrors = append(lineErrors, errs.LineError{Line: line, Message: "Expected a checklist item (\"- [ ] name\")"})
+		case current == nil:
+			lineErrors = append(lineErrors, errs.LineError{Line: line, Message: "Text outside of a list, expected a heading first"})
+		case task != nil || len(current.List.Tasks) > 0:
+			lineErrors = append(lineErrors, errs.LineError{Line: line, Message: "Unexpected text after checklist item, task descriptions must be indented"})
+		default:
+			descriptionLines = append(descriptionLines, trimmed)
+		}
+	}
+	finishList()
+
+	if err := scanner.Err(); err != nil {
+		lineErrors = append(lineErrors, errs.LineError{Line: line + 1, Message: err.Error()})
+	}
+
+	return parsed, lineErrors
+}
+
+/*
+ * Function: singleLine
+ * --------------------
+ * Replaces line breaks with spaces so that a value fits into a single line of output.
+ *
+ * s: the string to be flattened.
+ *
+ * returns: the flattened string.
+ */
+
+func singleLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
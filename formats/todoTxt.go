@@ -0,0 +1,143 @@
+/*
+ * package: formats
+ * --------------------
+ * Includes renderers and parsers for exporting and importing domain.ToDoList values in plain text formats
+ * (CSV, Markdown, todo.txt) as well as iCalendar feeds.
+ */
+
+package formats
+
+import (
+	"bufio"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"io"
+	"regexp"
+	"strings"
+)
+
+const todoTxtDefaultList = "Inbox"
+
+var todoTxtPrefix = regexp.MustCompile(`^(x\s+)?(\([A-Z]\)\s+)?(\d{4}-\d{2}-\d{2}\s+)?(\d{4}-\d{2}-\d{2}\s+)?`)
+
+/*
+ * Function: renderTodoTxt
+ * --------------------
+ * Renders one todo.txt line per task (see https://github.com/todotxt/todo.txt). Done tasks are prefixed with "x",
+ * the list a task belongs to is written as a +project tag with whitespace replaced by underscores and due dates as
+ * due:YYYY-MM-DD tag. Descriptions are not part of the format and are therefore omitted.
+ *
+ * w: the io.Writer to render into.
+ * lists: a slice of domain.ToDoList to be rendered.
+ *
+ * returns: an error if writing fails, nil otherwise.
+ */
+
+func renderTodoTxt(w io.Writer, lists []domain.ToDoList) error {
+	writer := bufio.NewWriter(w)
+
+	for _, list := range lists {
+		project := projectTag(list.Name)
+		for _, task := range list.Tasks {
+			if task.Done {
+				writer.WriteString("x ")
+			}
+			writer.WriteString(singleLine(task.Name) + " " + project)
+			if task.Due != nil {
+				writer.WriteString(" due:" + task.Due.UTC().Format(dateLayout))
+			}
+			writer.WriteString("\n")
+		}
+	}
+
+	return writer.Flush()
+}
+
+/*
+ * Function: projectTag
+ * --------------------
+ * Converts a list name into a todo.txt project tag.
+ *
+ * name: the name of the list.
+ *
+ * returns: the project tag, e.g. "+My_List" for "My List".
+ */
+
+func projectTag(name string) string {
+	return "+" + strings.Join(strings.Fields(name), "_")
+}
+
+/*
+ * Function: parseTodoTxt
+ * --------------------
+ * Parses todo.txt lines into tasks. Lines starting with "x" are marked done, priorities and dates at the beginning
+ * of a line are stripped. The first +project tag of a line determines the list (underscores are converted to
+ * spaces), lines without project are collected in a list named "Inbox". A due:YYYY-MM-DD tag sets the due date.
+ * All other words, including contexts and other key:value tags, form the task name.
+ *
+ * r: the io.Reader to parse from.
+ *
+ * returns: a slice of ParsedList and a slice of errs.LineError.
+ */
+
+func parseTodoTxt(r io.Reader) ([]ParsedList, []errs.LineError) {
+	var parsed []ParsedList
+	var lineErrors []errs.LineError
+	listIndex := make(map[string]int)
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		prefix := todoTxtPrefix.FindStringSubmatch(text)
+		task := domain.Task{Done: prefix[1] != ""}
+		text = text[len(prefix[0]):]
+
+		listName := ""
+		var words []string
+		for _, word := range strings.Fields(text) {
+			if listName == "" && len(word) > 1 && strings.HasPrefix(word, "+") {
+				listName = strings.ReplaceAll(word[1:], "_", " ")
+				continue
+			}
+			if strings.HasPrefix(word, "due:") {
+				due, err := parseDue(strings.TrimPrefix(word, "due:"))
+				if err != nil {
+					lineErrors = append(lineErrors, errs.LineError{Line: line, Message: err.Error()})
+				}
+				task.Due = due
+				continue
+			}
+			words = append(words, word)
+		}
+		if len(words) == 0 {
+			lineErrors = append(lineErrors, errs.LineError{Line: line, Message: "Missing task description"})
+			continue
+		}
+		if listName == "" {
+			listName = todoTxtDefaultList
+		}
+
+		i, ok := listIndex[listName]
+		if !ok {
+			i = len(parsed)
+			listIndex[listName] = i
+			parsed = append(parsed, ParsedList{
+				List: domain.ToDoList{Name: listName, Tasks: []domain.Task{}},
+				Line: line,
+			})
+		}
+		task.Name = strings.Join(words, " ")
+		parsed[i].List.Tasks = append(parsed[i].List.Tasks, task)
+	}
+
+	if err := scanner.Err(); err != nil {
+		lineErrors = append(lineErrors, errs.LineError{Line: line + 1, Message: err.Error()})
+	}
+
+	return parsed, lineErrors
+}
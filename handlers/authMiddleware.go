@@ -0,0 +1,45 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/luschnat-ziegler/toDoListAPI/auth"
+	"github.com/luschnat-ziegler/toDoListAPI/logger"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+/*
+ * Function: Authenticate
+ * --------------------
+ * Instantiates a middleware rejecting requests that cannot be authenticated (see auth.Authenticator.Authenticate)
+ * with code 401 and a WWW-Authenticate challenge. For authenticated requests, the principal is stored in the
+ * request context (see auth.PrincipalFromContext) and its subject added to the request-scoped logger.
+ *
+ * authenticator: a pointer to the auth.Authenticator to be used
+ *
+ * returns: a mux.MiddlewareFunc
+ */
+
+func Authenticate(authenticator *auth.Authenticator) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, appErr := authenticator.Authenticate(r)
+			if appErr != nil {
+				logger.FromContext(r.Context()).Warn("Authentication failed: " + appErr.Message)
+				w.Header().Set("WWW-Authenticate", `Bearer realm="todos"`)
+				writeError(w, r, appErr)
+				return
+			}
+
+			ctx := auth.WithPrincipal(r.Context(), principal)
+			ctx = logger.WithContext(ctx, logger.FromContext(ctx).With(zap.String("subject", principal.Subject)))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
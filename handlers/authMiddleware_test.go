@@ -0,0 +1,129 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/luschnat-ziegler/toDoListAPI/auth"
+	"github.com/luschnat-ziegler/toDoListAPI/config"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+/*
+ * function: authenticatedRouter
+ * --------------------
+ * Instantiates a router serving /todos behind the Authenticate middleware with a single API key, recording the
+ * principal seen by the handler.
+ *
+ * principal: a pointer to the auth.Principal to be set by the handler
+ *
+ * Returns: a pointer to the mux.Router
+ */
+
+func authenticatedRouter(principal *auth.Principal) *mux.Router {
+	authenticator, _ := auth.NewAuthenticator(config.Auth{APIKeys: map[string]string{"ci": "key-123"}})
+	authRouter := mux.NewRouter()
+	authRouter.Use(Authenticate(authenticator))
+	authRouter.HandleFunc("/todos", func(w http.ResponseWriter, r *http.Request) {
+		*principal, _ = auth.PrincipalFromContext(r.Context())
+	})
+	return authRouter
+}
+
+/*
+ * function: Test_Authenticate_should_write_401_without_credentials
+ * --------------------
+ * Tests if the middleware writes code 401, a WWW-Authenticate challenge and the error message to the response
+ * without calling the handler if the request cannot be authenticated.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Authenticate_should_write_401_without_credentials(t *testing.T) {
+	var principal auth.Principal
+	authRouter := authenticatedRouter(&principal)
+
+	request, _ := http.NewRequest(http.MethodGet, "/todos", nil)
+	recorder := httptest.NewRecorder()
+	authRouter.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusUnauthorized {
+		t.Errorf("Expected code 401, got %v instead", recorder.Code)
+	}
+	if recorder.Header().Get("WWW-Authenticate") == "" {
+		t.Error("Expected WWW-Authenticate header, got none")
+	}
+	if body := recorder.Body.String(); !strings.Contains(body, `"code":"unauthorized"`) {
+		t.Errorf("Unexpected response body %v", body)
+	}
+	if principal.Subject != "" {
+		t.Error("Expected handler not to be called")
+	}
+}
+
+/*
+ * function: Test_Authenticate_should_pass_principal_to_handler
+ * --------------------
+ * Tests if the middleware calls the handler with the authenticated principal in the request context.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Authenticate_should_pass_principal_to_handler(t *testing.T) {
+	var principal auth.Principal
+	authRouter := authenticatedRouter(&principal)
+
+	request, _ := http.NewRequest(http.MethodGet, "/todos", nil)
+	request.Header.Set(auth.APIKeyHeader, "key-123")
+	recorder := httptest.NewRecorder()
+	authRouter.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusOK {
+		t.Errorf("Expected code 200, got %v instead", recorder.Code)
+	}
+	if principal.Subject != "apikey:ci" {
+		t.Errorf("Expected principal apikey:ci, got %+v instead", principal)
+	}
+}
+
+/*
+ * function: Test_Authenticate_should_write_german_details_if_accepted
+ * --------------------
+ * Tests if the details of authentication failures are translated into German if German is preferred in the
+ * Accept-Language header, including the reason of an invalid token.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Authenticate_should_write_german_details_if_accepted(t *testing.T) {
+	var principal auth.Principal
+	authRouter := authenticatedRouter(&principal)
+
+	for header, expected := range map[string]string{
+		auth.APIKeyHeader: `"detail":"Ungültiger API-Schlüssel"`,
+		"Authorization":   `"detail":"Nicht unterstütztes Autorisierungsschema, erwartet wird Bearer"`,
+	} {
+		request, _ := http.NewRequest(http.MethodGet, "/todos", nil)
+		request.Header.Set(header, "Basic guess")
+		request.Header.Set("Accept-Language", "de")
+		recorder := httptest.NewRecorder()
+		authRouter.ServeHTTP(recorder, request)
+
+		if body := recorder.Body.String(); recorder.Code != http.StatusUnauthorized || !strings.Contains(body, expected) {
+			t.Errorf("Header %s: expected code 401 and %s, got %v and %s instead", header, expected, recorder.Code, body)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"net/http"
+	"strconv"
+)
+
+/*
+ * Function: LimitBody
+ * --------------------
+ * Instantiates a middleware limiting the size of request bodies. Requests announcing a larger body in their
+ * Content-Length header are rejected with code 413 right away; other bodies are wrapped by http.MaxBytesReader, so
+ * that reading beyond the limit fails and is answered with code 413 as well (see readBody).
+ *
+ * maxBytes: the maximum body size in bytes
+ *
+ * returns: a mux.MiddlewareFunc
+ */
+
+func LimitBody(maxBytes int64) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.ContentLength > maxBytes {
+				appErr := errs.Localized(errs.NewRequestEntityTooLargeError, errs.MessageBodyLimit, "limit", strconv.FormatInt(maxBytes, 10))
+				writeError(w, r, appErr)
+				return
+			}
+			r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"bytes"
+	"github.com/gorilla/mux"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+/*
+ * function: Test_LimitBody_should_write_413_for_bodies_exceeding_limit
+ * --------------------
+ * Tests if bodies exceeding the limit are answered with code 413, whether announced by Content-Length or detected
+ * while decoding a body of unknown length, and bodies within the limit are decoded.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_LimitBody_should_write_413_for_bodies_exceeding_limit(t *testing.T) {
+	limitedRouter := mux.NewRouter()
+	limitedRouter.Use(LimitBody(64))
+	limitedRouter.HandleFunc("/todos", func(w http.ResponseWriter, r *http.Request) {
+		var list domain.ToDoList
+		if appErr := decodeBody(r, &list); appErr != nil {
+			writeError(w, r, appErr)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	large := `{"name":"` + strings.Repeat("x", 100) + `"}`
+	for name, body := range map[string]io.Reader{
+		"announced": bytes.NewBufferString(large),
+		"streamed":  io.MultiReader(strings.NewReader(large)),
+	} {
+		request, _ := http.NewRequest(http.MethodPost, "/todos", body)
+		recorder := httptest.NewRecorder()
+
+		limitedRouter.ServeHTTP(recorder, request)
+
+		if recorder.Code != http.StatusRequestEntityTooLarge {
+			t.Errorf("Expected code 413 for %s body, got %v instead", name, recorder.Code)
+		}
+	}
+
+	request, _ := http.NewRequest(http.MethodPost, "/todos", bytes.NewBufferString(`{"name":"small"}`))
+	recorder := httptest.NewRecorder()
+	limitedRouter.ServeHTTP(recorder, request)
+	if recorder.Code != http.StatusCreated {
+		t.Errorf("Expected code 201 for small body, got %v instead", recorder.Code)
+	}
+}
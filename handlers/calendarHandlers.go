@@ -0,0 +1,111 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/gorilla/mux"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/formats"
+	"github.com/luschnat-ziegler/toDoListAPI/logger"
+	"net/http"
+)
+
+/*
+ * Method: ToDoListHandlers.Calendar
+ * --------------------
+ * To be called when the tasks of one specific list are requested as iCalendar feed. Writes the feed to the response
+ * body and code 200 to the header (see writeCalendar). If a pointer to an errs.AppError is returned by the service
+ * method, its message is written to the response body and its Code to the header, instead.
+ *
+ * w, r: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
+ *
+ * returns: nothing
+ */
+
+func (ah *ToDoListHandlers) Calendar(w http.ResponseWriter, r *http.Request) {
+
+	id := mux.Vars(r)["id"]
+
+	list, appErr := ah.Service.GetOneListById(r.Context(), id)
+	if appErr != nil {
+		writeError(w, r, appErr)
+		return
+	}
+
+	writeCalendar(w, r, []domain.ToDoList{*list})
+}
+
+/*
+ * Method: ToDoListHandlers.CalendarAll
+ * --------------------
+ * To be called when the tasks of all lists are requested as iCalendar feed. Writes the feed to the response body
+ * and code 200 to the header (see writeCalendar). If a pointer to an errs.AppError is returned by the service
+ * method, its message is written to the response body and its Code to the header, instead.
+ *
+ * w, r: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
+ *
+ * returns: nothing
+ */
+
+func (ah *ToDoListHandlers) CalendarAll(w http.ResponseWriter, r *http.Request) {
+
+	lists, appErr := ah.Service.GetAllLists(r.Context())
+	if appErr != nil {
+		writeError(w, r, appErr)
+		return
+	}
+
+	var output []domain.ToDoList
+	if lists != nil {
+		output = *lists
+	}
+
+	writeCalendar(w, r, output)
+}
+
+/*
+ * Function: writeCalendar
+ * --------------------
+ * Utility function for writing an iCalendar feed. The feed is rendered into a buffer first and an ETag is derived
+ * from its content, so that polling clients sending a matching If-None-Match header are answered with code 304 and
+ * no body.
+ *
+ * w: an http.ResponseWriter to be used for writing the response
+ * r: a pointer to the http.Request, checked for an If-None-Match header
+ * lists: the lists whose tasks are to be rendered
+ *
+ * returns: nothing
+ */
+
+func writeCalendar(w http.ResponseWriter, r *http.Request, lists []domain.ToDoList) {
+	var body bytes.Buffer
+	if err := formats.RenderCalendar(&body, lists); err != nil {
+		logger.FromContext(r.Context()).Error("Error rendering calendar: " + err.Error())
+		appErr := errs.NewInternalError("Calendar error")
+		writeError(w, r, appErr)
+		return
+	}
+
+	hash := sha256.Sum256(body.Bytes())
+	etag := `"` + hex.EncodeToString(hash[:16]) + `"`
+	w.Header().Add("ETag", etag)
+
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Add("Content-Type", formats.CalendarContentType)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(body.Bytes()); err != nil {
+		logger.FromContext(r.Context()).Error("Error writing calendar: " + err.Error())
+	}
+}
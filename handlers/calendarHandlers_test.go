@@ -0,0 +1,130 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/testUtils/dummies"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+/*
+ * function: Test_ToDoListHandlers_Calendar_should_write_feed_with_etag
+ * --------------------
+ * Tests if method writes the iCalendar feed to the response body as well as status code 200, the calendar content
+ * type and an ETag if service method returns pointer to domain.ToDoList and nil.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_Calendar_should_write_feed_with_etag(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	router.HandleFunc("/todos/{id}/calendar.ics", th.Calendar)
+	mockDefaultToDoListService.EXPECT().GetOneListById(gomock.Any(), "test_id").Return(&dummies.DummyListWithDueDates, nil).Times(1)
+
+	request, _ := http.NewRequest(http.MethodGet, "/todos/test_id/calendar.ics", nil)
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusOK {
+		t.Errorf("Expected code 200, got %v instead", recorder.Code)
+	}
+
+	if contentType := recorder.Header().Get("Content-Type"); contentType != "text/calendar; charset=utf-8" {
+		t.Errorf("Expected calendar content type, got %v instead", contentType)
+	}
+
+	if recorder.Header().Get("ETag") == "" {
+		t.Error("Expected ETag header, got none")
+	}
+
+	if recorder.Body.String() != dummies.DummyListWithDueDatesAsICalendar {
+		t.Error("Response body does not match")
+	}
+}
+
+/*
+ * function: Test_ToDoListHandlers_CalendarAll_should_write_304_if_etag_matches
+ * --------------------
+ * Tests if method writes status code 304 and no body if the If-None-Match header matches the ETag of the feed.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_CalendarAll_should_write_304_if_etag_matches(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	router.HandleFunc("/calendar.ics", th.CalendarAll)
+	dummyLists := []domain.ToDoList{
+		dummies.DummyListWithDueDates,
+	}
+	mockDefaultToDoListService.EXPECT().GetAllLists(gomock.Any()).Return(&dummyLists, nil).Times(2)
+
+	request, _ := http.NewRequest(http.MethodGet, "/calendar.ics", nil)
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	request, _ = http.NewRequest(http.MethodGet, "/calendar.ics", nil)
+	request.Header.Set("If-None-Match", recorder.Header().Get("ETag"))
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusNotModified {
+		t.Errorf("Expected code 304, got %v instead", recorder.Code)
+	}
+
+	if recorder.Body.Len() != 0 {
+		t.Error("Expected empty response body")
+	}
+}
+
+/*
+ * function: Test_ToDoListHandlers_Calendar_should_write_error_returned_by_service_method_to_json_body
+ * --------------------
+ * Tests if method writes correct JSON to response body as well as the correct error code if service method returns
+ * nil and a pointer to an errs.AppError
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_Calendar_should_write_error_returned_by_service_method_to_json_body(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	router.HandleFunc("/todos/{id}/calendar.ics", th.Calendar)
+	mockDefaultToDoListService.EXPECT().GetOneListById(gomock.Any(), "test_id").Return(nil, dummies.DummyInternalError).Times(1)
+
+	request, _ := http.NewRequest(http.MethodGet, "/todos/test_id/calendar.ics", nil)
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusInternalServerError {
+		t.Errorf("Expected code 500, got %v instead", recorder.Code)
+	}
+
+	resBody := recorder.Body.String()
+	resBody = resBody[:len(resBody)-1]
+
+	if resBody != fmt.Sprintf(dummies.DummyInternalErrorAsJSON, "/todos/test_id/calendar.ics") {
+		t.Error("Response body does not match")
+	}
+}
@@ -0,0 +1,77 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"net/http"
+)
+
+/*
+ * Method: ToDoListHandlers.SetCollaborator
+ * --------------------
+ * To be called when a list is requested to be shared with the subject given in the path or when the role of an
+ * existing collaborator is to be changed. The role is read from the request body (see decodeBody), a subject
+ * provided in the body is ignored. Rejects bodies that cannot be decoded and collaborators failing validation.
+ * On success, the updated list is written to the response body and code 200 to the header. If a pointer to an
+ * errs.AppError is returned by the service method, its message is written to the response body and its Code to
+ * the header, instead.
+ *
+ * w, r: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
+ *
+ * returns: nothing
+ */
+
+func (ah *ToDoListHandlers) SetCollaborator(w http.ResponseWriter, r *http.Request) {
+
+	vars := mux.Vars(r)
+
+	var collaborator domain.Collaborator
+	if appErr := decodeBody(r, &collaborator); appErr != nil {
+		writeError(w, r, appErr)
+		return
+	}
+	collaborator.Subject = vars["subject"]
+
+	if validationError := collaborator.Validate(); validationError != nil {
+		writeError(w, r, validationError)
+		return
+	}
+
+	list, appErr := ah.Service.SetCollaborator(r.Context(), vars["id"], collaborator)
+	if appErr != nil {
+		writeError(w, r, appErr)
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, list)
+}
+
+/*
+ * Method: ToDoListHandlers.RemoveCollaborator
+ * --------------------
+ * To be called when a list is requested to no longer be shared with the subject given in the path. Writes no
+ * response body and code 204 to the header. If a pointer to an errs.AppError is returned by the service method,
+ * its message is written to the response body and its Code to the header, instead.
+ *
+ * w, r: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
+ *
+ * returns: nothing
+ */
+
+func (ah *ToDoListHandlers) RemoveCollaborator(w http.ResponseWriter, r *http.Request) {
+
+	vars := mux.Vars(r)
+
+	appErr := ah.Service.RemoveCollaborator(r.Context(), vars["id"], vars["subject"])
+	if appErr != nil {
+		writeError(w, r, appErr)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,111 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"bytes"
+	"github.com/golang/mock/gomock"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/testUtils/dummies"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+/*
+ * function: Test_ToDoListHandlers_SetCollaborator_should_pass_subject_from_path_to_service_method
+ * --------------------
+ * Tests if the subject is taken from the path (ignoring a subject in the body) and the list returned by the service
+ * method is written to the response body with status code 200.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_SetCollaborator_should_pass_subject_from_path_to_service_method(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	router.HandleFunc("/todos/{id}/collaborators/{subject}", th.SetCollaborator)
+	collaborator := domain.Collaborator{Subject: "bob", Role: domain.RoleEditor}
+	mockDefaultToDoListService.EXPECT().SetCollaborator(gomock.Any(), "test_id", collaborator).
+		Return(&dummies.DummyListValidWithIds, nil).
+		Times(1)
+
+	body := bytes.NewBufferString(`{"subject":"mallory","role":"editor"}`)
+	request, _ := http.NewRequest(http.MethodPut, "/todos/test_id/collaborators/bob", body)
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusOK {
+		t.Errorf("Expected code 200, got %v instead", recorder.Code)
+	}
+}
+
+/*
+ * function: Test_ToDoListHandlers_SetCollaborator_should_write_validation_error_for_unknown_role
+ * --------------------
+ * Tests if method writes a validation error with status code 400 without calling the service method if the role
+ * is unknown.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_SetCollaborator_should_write_validation_error_for_unknown_role(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	router.HandleFunc("/todos/{id}/collaborators/{subject}", th.SetCollaborator)
+
+	body := bytes.NewBufferString(`{"role":"admin"}`)
+	request, _ := http.NewRequest(http.MethodPut, "/todos/test_id/collaborators/bob", body)
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("Expected code 400, got %v instead", recorder.Code)
+	}
+
+	if !strings.Contains(recorder.Body.String(), `"role":"oneof"`) {
+		t.Errorf("Expected invalid role in response body, got %v instead", recorder.Body.String())
+	}
+}
+
+/*
+ * function: Test_ToDoListHandlers_RemoveCollaborator_should_write_204_if_service_method_returns_no_error
+ * --------------------
+ * Tests if method writes status code 204 if service method returns nil.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_RemoveCollaborator_should_write_204_if_service_method_returns_no_error(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	router.HandleFunc("/todos/{id}/collaborators/{subject}", th.RemoveCollaborator)
+	mockDefaultToDoListService.EXPECT().RemoveCollaborator(gomock.Any(), "test_id", "bob").Return(nil).Times(1)
+
+	request, _ := http.NewRequest(http.MethodDelete, "/todos/test_id/collaborators/bob", nil)
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusNoContent {
+		t.Errorf("Expected code 204, got %v instead", recorder.Code)
+	}
+}
@@ -0,0 +1,151 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/luschnat-ziegler/toDoListAPI/config"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+/*
+ * Function: CORS
+ * --------------------
+ * Instantiates a middleware implementing cross-origin resource sharing for browser clients. Preflight requests
+ * (OPTIONS with an Access-Control-Request-Method header) are answered directly: with code 204 and the allowed
+ * methods, headers and max age if origin, method and headers are allowed, with code 403 otherwise. Other requests
+ * from allowed origins are passed on with the Access-Control-Allow-Origin and Access-Control-Expose-Headers
+ * headers set. Requests without Origin header are passed on unchanged.
+ * Preflights neither match the routes (which are registered for their methods only) nor carry credentials, so the
+ * middleware has to wrap the mux.Router instead of being registered with mux.Router.Use.
+ *
+ * settings: the config.CORS to be applied
+ *
+ * returns: a mux.MiddlewareFunc
+ */
+
+func CORS(settings config.CORS) mux.MiddlewareFunc {
+	allowedMethods := strings.Join(settings.AllowedMethods, ", ")
+	allowedHeaders := strings.Join(settings.AllowedHeaders, ", ")
+	exposedHeaders := strings.Join(settings.ExposedHeaders, ", ")
+	maxAge := strconv.Itoa(int(settings.MaxAge.Seconds()))
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			if origin == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			w.Header().Add("Vary", "Origin")
+			requestedMethod := r.Header.Get("Access-Control-Request-Method")
+			preflight := r.Method == http.MethodOptions && requestedMethod != ""
+
+			if preflight {
+				w.Header().Add("Vary", "Access-Control-Request-Method")
+				w.Header().Add("Vary", "Access-Control-Request-Headers")
+				var appErr *errs.AppError
+				switch {
+				case !allowsOrigin(settings, origin):
+					appErr = errs.Localized(errs.NewForbiddenError, errs.MessageCORSOrigin, "origin", origin)
+				case !containsValue(settings.AllowedMethods, requestedMethod, false):
+					appErr = errs.Localized(errs.NewForbiddenError, errs.MessageCORSMethod, "method", requestedMethod)
+				default:
+					for _, header := range strings.Split(r.Header.Get("Access-Control-Request-Headers"), ",") {
+						if header = strings.TrimSpace(header); header != "" && !containsValue(settings.AllowedHeaders, header, true) {
+							appErr = errs.Localized(errs.NewForbiddenError, errs.MessageCORSHeader, "header", header)
+							break
+						}
+					}
+				}
+				if appErr != nil {
+					writeError(w, r, appErr)
+					return
+				}
+
+				setAllowOrigin(w, settings, origin)
+				w.Header().Set("Access-Control-Allow-Methods", allowedMethods)
+				if allowedHeaders != "" {
+					w.Header().Set("Access-Control-Allow-Headers", allowedHeaders)
+				}
+				w.Header().Set("Access-Control-Max-Age", maxAge)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			if allowsOrigin(settings, origin) {
+				setAllowOrigin(w, settings, origin)
+				if exposedHeaders != "" {
+					w.Header().Set("Access-Control-Expose-Headers", exposedHeaders)
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+/*
+ * Function: allowsOrigin
+ * --------------------
+ * Checks whether an origin is allowed, either explicitly or by the wildcard "*".
+ *
+ * settings: the config.CORS to be applied
+ * origin: the value of the Origin header
+ *
+ * returns: true if the origin is allowed, false otherwise
+ */
+
+func allowsOrigin(settings config.CORS, origin string) bool {
+	return containsValue(settings.AllowedOrigins, "*", false) || containsValue(settings.AllowedOrigins, origin, false)
+}
+
+/*
+ * Function: setAllowOrigin
+ * --------------------
+ * Sets the Access-Control-Allow-Origin header and, if configured, Access-Control-Allow-Credentials. The wildcard
+ * is answered as such, explicitly allowed origins are echoed.
+ *
+ * w: the http.ResponseWriter
+ * settings: the config.CORS to be applied
+ * origin: the value of the Origin header
+ *
+ * returns: nothing
+ */
+
+func setAllowOrigin(w http.ResponseWriter, settings config.CORS, origin string) {
+	if containsValue(settings.AllowedOrigins, "*", false) {
+		origin = "*"
+	}
+	w.Header().Set("Access-Control-Allow-Origin", origin)
+	if settings.AllowCredentials {
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+	}
+}
+
+/*
+ * Function: containsValue
+ * --------------------
+ * Checks whether a slice of strings contains a value.
+ *
+ * values: the slice to be searched
+ * value: the value to search for
+ * ignoreCase: whether to compare case-insensitively, as required for header names
+ *
+ * returns: true if value is contained, false otherwise
+ */
+
+func containsValue(values []string, value string, ignoreCase bool) bool {
+	for _, v := range values {
+		if v == value || (ignoreCase && strings.EqualFold(v, value)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/luschnat-ziegler/toDoListAPI/config"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+/*
+ * function: corsHandler
+ * --------------------
+ * Instantiates a router serving GET and PUT on /todos/{id} wrapped by the CORS middleware, allowing the origin
+ * https://app.example.com with credentials.
+ *
+ * Returns: the wrapping http.Handler
+ */
+
+func corsHandler() http.Handler {
+	settings := config.Default().CORS
+	settings.AllowedOrigins = []string{"https://app.example.com"}
+	settings.AllowCredentials = true
+
+	corsRouter := mux.NewRouter()
+	corsRouter.HandleFunc("/todos/{id}", func(w http.ResponseWriter, r *http.Request) {}).Methods(http.MethodGet, http.MethodPut)
+	return CORS(settings)(corsRouter)
+}
+
+/*
+ * function: Test_CORS_should_answer_preflight_of_allowed_origin
+ * --------------------
+ * Tests if a preflight for an allowed origin, method and headers is answered with code 204 and the CORS headers
+ * without reaching the router, which has no OPTIONS route.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_CORS_should_answer_preflight_of_allowed_origin(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodOptions, "/todos/test_id", nil)
+	request.Header.Set("Origin", "https://app.example.com")
+	request.Header.Set("Access-Control-Request-Method", http.MethodPut)
+	request.Header.Set("Access-Control-Request-Headers", "content-type, authorization")
+	recorder := httptest.NewRecorder()
+
+	corsHandler().ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusNoContent {
+		t.Errorf("Expected code 204, got %v instead", recorder.Code)
+	}
+	expected := map[string]string{
+		"Access-Control-Allow-Origin":      "https://app.example.com",
+		"Access-Control-Allow-Credentials": "true",
+		"Access-Control-Allow-Methods":     "GET, POST, PUT, DELETE",
+		"Access-Control-Max-Age":           "600",
+	}
+	for header, value := range expected {
+		if got := recorder.Header().Get(header); got != value {
+			t.Errorf("Expected %s %q, got %q instead", header, value, got)
+		}
+	}
+}
+
+/*
+ * function: Test_CORS_should_reject_preflight_of_unknown_origin_or_header
+ * --------------------
+ * Tests if preflights from origins not allowed or requesting headers not allowed are answered with code 403 and
+ * without Access-Control-Allow-Origin header.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_CORS_should_reject_preflight_of_unknown_origin_or_header(t *testing.T) {
+	for origin, headers := range map[string]string{
+		"https://evil.example.com": "content-type",
+		"https://app.example.com":  "x-custom",
+	} {
+		request, _ := http.NewRequest(http.MethodOptions, "/todos/test_id", nil)
+		request.Header.Set("Origin", origin)
+		request.Header.Set("Access-Control-Request-Method", http.MethodGet)
+		request.Header.Set("Access-Control-Request-Headers", headers)
+		recorder := httptest.NewRecorder()
+
+		corsHandler().ServeHTTP(recorder, request)
+
+		if recorder.Code != http.StatusForbidden || recorder.Header().Get("Access-Control-Allow-Origin") != "" {
+			t.Errorf("Expected code 403 without allowed origin for %s and %s, got %v", origin, headers, recorder.Code)
+		}
+	}
+}
+
+/*
+ * function: Test_CORS_should_add_headers_to_requests_of_allowed_origins_only
+ * --------------------
+ * Tests if actual requests reach the router in any case, but carry CORS headers for allowed origins only.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_CORS_should_add_headers_to_requests_of_allowed_origins_only(t *testing.T) {
+	for origin, allowOrigin := range map[string]string{
+		"https://app.example.com":  "https://app.example.com",
+		"https://evil.example.com": "",
+	} {
+		request, _ := http.NewRequest(http.MethodGet, "/todos/test_id", nil)
+		request.Header.Set("Origin", origin)
+		recorder := httptest.NewRecorder()
+
+		corsHandler().ServeHTTP(recorder, request)
+
+		if recorder.Code != http.StatusOK {
+			t.Errorf("Expected code 200 for %s, got %v instead", origin, recorder.Code)
+		}
+		if got := recorder.Header().Get("Access-Control-Allow-Origin"); got != allowOrigin {
+			t.Errorf("Expected Access-Control-Allow-Origin %q for %s, got %q instead", allowOrigin, origin, got)
+		}
+		if allowOrigin != "" && recorder.Header().Get("Access-Control-Expose-Headers") == "" {
+			t.Error("Expected exposed headers for allowed origin")
+		}
+	}
+}
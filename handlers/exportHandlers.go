@@ -0,0 +1,140 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/formats"
+	"github.com/luschnat-ziegler/toDoListAPI/logger"
+	"net/http"
+	"strings"
+)
+
+/*
+ * Method: ToDoListHandlers.Export
+ * --------------------
+ * To be called when one specific list is requested in an export format (query parameter "format"). Writes the
+ * rendered list as an attachment to the response body and code 200 to the header. Unknown formats are rejected with
+ * code 400. If a pointer to an errs.AppError is returned by the service method, its message is written to the
+ * response body and its Code to the header, instead.
+ *
+ * w, r: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
+ *
+ * returns: nothing
+ */
+
+func (ah *ToDoListHandlers) Export(w http.ResponseWriter, r *http.Request) {
+
+	format, appErr := exportFormat(r)
+	if appErr != nil {
+		writeError(w, r, appErr)
+		return
+	}
+
+	id := mux.Vars(r)["id"]
+
+	list, appErr := ah.Service.GetOneListById(r.Context(), id)
+	if appErr != nil {
+		writeError(w, r, appErr)
+		return
+	}
+
+	writeExport(w, r, format, "todo-"+list.Id.Hex(), []domain.ToDoList{*list})
+}
+
+/*
+ * Method: ToDoListHandlers.ExportAll
+ * --------------------
+ * To be called when all lists are requested in an export format (query parameter "format"). Writes the rendered
+ * lists as an attachment to the response body and code 200 to the header. Unknown formats are rejected with
+ * code 400. If a pointer to an errs.AppError is returned by the service method, its message is written to the
+ * response body and its Code to the header, instead.
+ *
+ * w, r: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
+ *
+ * returns: nothing
+ */
+
+func (ah *ToDoListHandlers) ExportAll(w http.ResponseWriter, r *http.Request) {
+
+	format, appErr := exportFormat(r)
+	if appErr != nil {
+		writeError(w, r, appErr)
+		return
+	}
+
+	lists, appErr := ah.Service.GetAllLists(r.Context())
+	if appErr != nil {
+		writeError(w, r, appErr)
+		return
+	}
+
+	var output []domain.ToDoList
+	if lists != nil {
+		output = *lists
+	}
+
+	writeExport(w, r, format, "todos", output)
+}
+
+/*
+ * Function: exportFormat
+ * --------------------
+ * Resolves the export format requested via the query parameter "format".
+ *
+ * r: a pointer to the http.Request carrying the query parameter.
+ *
+ * returns: the requested formats.Format and nil if it is supported.
+ *          Otherwise, the zero value and a pointer to an errs.AppError (code 400) are returned.
+ */
+
+func exportFormat(r *http.Request) (formats.Format, *errs.AppError) {
+	name := r.URL.Query().Get("format")
+	format, ok := formats.Lookup(name)
+	if !ok {
+		return formats.Format{}, errs.NewBadRequestError(
+			fmt.Sprintf("Unsupported export format %q, expected one of: %s", name, strings.Join(formats.Names(), ", ")),
+		)
+	}
+	return format, nil
+}
+
+/*
+ * Function: writeExport
+ * --------------------
+ * Utility function for writing rendered lists as a file download. The lists are rendered into a buffer first, so
+ * that a rendering error can still be answered with code 500.
+ *
+ * w: an http.ResponseWriter to be used for writing the response
+ * r: a pointer to the http.Request to be answered
+ * format: the formats.Format to render the lists in
+ * baseName: the file name (without extension) suggested to the client
+ * lists: the lists to be rendered
+ *
+ * returns: nothing
+ */
+
+func writeExport(w http.ResponseWriter, r *http.Request, format formats.Format, baseName string, lists []domain.ToDoList) {
+	var body bytes.Buffer
+	if err := format.Render(&body, lists); err != nil {
+		logger.FromContext(r.Context()).Error("Error rendering export: " + err.Error())
+		appErr := errs.NewInternalError("Export error")
+		writeError(w, r, appErr)
+		return
+	}
+
+	w.Header().Add("Content-Type", format.ContentType)
+	w.Header().Add("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, baseName, format.Extension))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(body.Bytes()); err != nil {
+		logger.FromContext(r.Context()).Error("Error writing export: " + err.Error())
+	}
+}
@@ -0,0 +1,167 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/testUtils/dummies"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+/*
+ * function: Test_ToDoListHandlers_Export_should_write_rendered_list_as_attachment
+ * --------------------
+ * Tests if method writes the rendered list to the response body as well as status code 200, the format's content
+ * type and a content disposition if service method returns pointer to domain.ToDoList and nil.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_Export_should_write_rendered_list_as_attachment(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	router.HandleFunc("/todos/{id}/export", th.Export)
+	mockDefaultToDoListService.EXPECT().GetOneListById(gomock.Any(), "test_id").Return(&dummies.DummyListValidWithIds, nil).Times(1)
+
+	request, _ := http.NewRequest(http.MethodGet, "/todos/test_id/export?format=csv", nil)
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusOK {
+		t.Errorf("Expected code 200, got %v instead", recorder.Code)
+	}
+
+	if contentType := recorder.Header().Get("Content-Type"); contentType != "text/csv; charset=utf-8" {
+		t.Errorf("Expected csv content type, got %v instead", contentType)
+	}
+
+	expectedDisposition := `attachment; filename="todo-601be448b9b5e15374b1e842.csv"`
+	if disposition := recorder.Header().Get("Content-Disposition"); disposition != expectedDisposition {
+		t.Errorf("Expected %v, got %v instead", expectedDisposition, disposition)
+	}
+
+	if recorder.Body.String() != dummies.DummyListValidWithIdsAsCSV {
+		t.Error("Response body does not match")
+	}
+}
+
+/*
+ * function: Test_ToDoListHandlers_Export_should_write_error_400_if_format_unsupported
+ * --------------------
+ * Tests if method writes correct JSON to response body as well as status code 400 without calling the service
+ * method if the requested format is not supported.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_Export_should_write_error_400_if_format_unsupported(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	router.HandleFunc("/todos/{id}/export", th.Export)
+
+	request, _ := http.NewRequest(http.MethodGet, "/todos/test_id/export?format=pdf", nil)
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("Expected code 400, got %v instead", recorder.Code)
+	}
+
+	resBody := recorder.Body.String()
+	resBody = resBody[:len(resBody)-1]
+
+	if resBody != fmt.Sprintf(dummies.DummyUnsupportedFormatErrorAsJSON, "/todos/test_id/export") {
+		t.Error("Response body does not match")
+	}
+}
+
+/*
+ * function: Test_ToDoListHandlers_Export_should_write_error_returned_by_service_method_to_json_body
+ * --------------------
+ * Tests if method writes correct JSON to response body as well as the correct error code if service method returns
+ * nil and a pointer to an errs.AppError
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_Export_should_write_error_returned_by_service_method_to_json_body(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	router.HandleFunc("/todos/{id}/export", th.Export)
+	mockDefaultToDoListService.EXPECT().GetOneListById(gomock.Any(), "test_id").Return(nil, dummies.DummyInternalError).Times(1)
+
+	request, _ := http.NewRequest(http.MethodGet, "/todos/test_id/export?format=markdown", nil)
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusInternalServerError {
+		t.Errorf("Expected code 500, got %v instead", recorder.Code)
+	}
+
+	resBody := recorder.Body.String()
+	resBody = resBody[:len(resBody)-1]
+
+	if resBody != fmt.Sprintf(dummies.DummyInternalErrorAsJSON, "/todos/test_id/export") {
+		t.Error("Response body does not match")
+	}
+}
+
+/*
+ * function: Test_ToDoListHandlers_ExportAll_should_write_rendered_lists_as_attachment
+ * --------------------
+ * Tests if method writes all rendered lists to the response body as well as status code 200 and a content
+ * disposition if service method returns pointer to slice of domain.ToDoList and nil.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_ExportAll_should_write_rendered_lists_as_attachment(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	router.HandleFunc("/todos/export", th.ExportAll)
+	dummyLists := []domain.ToDoList{
+		dummies.DummyListValidWithIds,
+	}
+	mockDefaultToDoListService.EXPECT().GetAllLists(gomock.Any()).Return(&dummyLists, nil).Times(1)
+
+	request, _ := http.NewRequest(http.MethodGet, "/todos/export?format=todotxt", nil)
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusOK {
+		t.Errorf("Expected code 200, got %v instead", recorder.Code)
+	}
+
+	expectedDisposition := `attachment; filename="todos.txt"`
+	if disposition := recorder.Header().Get("Content-Disposition"); disposition != expectedDisposition {
+		t.Errorf("Expected %v, got %v instead", expectedDisposition, disposition)
+	}
+
+	if recorder.Body.String() != dummies.DummyListValidWithIdsAsTodoTxt {
+		t.Error("Response body does not match")
+	}
+}
@@ -0,0 +1,86 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"net/http"
+	"strings"
+)
+
+/*
+ * Function: NotFound
+ * --------------------
+ * To be registered as mux.Router.NotFoundHandler. Writes a problem with code 404 for paths matching no route.
+ *
+ * w, r: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
+ *
+ * returns: nothing
+ */
+
+func NotFound(w http.ResponseWriter, r *http.Request) {
+	writeError(w, r, errs.Localized(errs.NewNotFoundError, errs.MessageRouteNotFound, "path", r.URL.Path))
+}
+
+/*
+ * Function: MethodNotAllowed
+ * --------------------
+ * To be registered as mux.Router.MethodNotAllowedHandler. Writes a problem with code 405 for paths whose routes do
+ * not accept the method of the request.
+ *
+ * w, r: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
+ *
+ * returns: nothing
+ */
+
+func MethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	writeError(w, r, errs.Localized(errs.NewMethodNotAllowedError, errs.MessageRouteMethod, "method", r.Method, "path", r.URL.Path))
+}
+
+/*
+ * Function: MalformedListId
+ * --------------------
+ * Instantiates a mux.MatcherFunc matching requests whose path continues after prefix with a segment that is not a
+ * valid domain.ListId. Routes of lists only match ids of the form domain.ListIdPattern, so a route registered last
+ * with this matcher and InvalidListId answers malformed ids with 400 instead of 404.
+ *
+ * prefix: the path preceding the id, e.g. "/todos/"
+ * reserved: segments after prefix that are routes of their own rather than ids, e.g. "export"
+ *
+ * returns: a mux.MatcherFunc
+ */
+
+func MalformedListId(prefix string, reserved ...string) mux.MatcherFunc {
+	return func(r *http.Request, _ *mux.RouteMatch) bool {
+		if !strings.HasPrefix(r.URL.Path, prefix) {
+			return false
+		}
+		segment := strings.SplitN(strings.TrimPrefix(r.URL.Path, prefix), "/", 2)[0]
+		if segment == "" || containsValue(reserved, segment, false) {
+			return false
+		}
+		_, err := domain.ParseListId(segment)
+		return err != nil
+	}
+}
+
+/*
+ * Function: InvalidListId
+ * --------------------
+ * To be registered for routes matched by MalformedListId. Writes a problem with code 400, the same as written for
+ * malformed ids by the services.
+ *
+ * w, r: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
+ *
+ * returns: nothing
+ */
+
+func InvalidListId(w http.ResponseWriter, r *http.Request) {
+	writeError(w, r, errs.Localized(errs.NewBadRequestError, errs.MessageInvalidId))
+}
@@ -0,0 +1,124 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"encoding/json"
+	"github.com/golang/mock/gomock"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/testUtils/dummies"
+	"github.com/luschnat-ziegler/toDoListAPI/testUtils/mocks/ports"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+/*
+ * function: Test_NotFound_and_MethodNotAllowed_should_write_problems
+ * --------------------
+ * Tests if unknown paths and methods are answered with problem details carrying status, error code and instance.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_NotFound_and_MethodNotAllowed_should_write_problems(t *testing.T) {
+	fallbackRouter := NewRouter()
+	fallbackRouter.HandleFunc("/todos", func(w http.ResponseWriter, r *http.Request) {}).Methods(http.MethodGet)
+
+	for _, test := range []struct {
+		method    string
+		path      string
+		status    int
+		errorCode string
+	}{
+		{http.MethodGet, "/unknown", http.StatusNotFound, errs.CodeNotFound},
+		{http.MethodPatch, "/todos", http.StatusMethodNotAllowed, errs.CodeMethodNotAllowed},
+	} {
+		request, _ := http.NewRequest(test.method, test.path, nil)
+		recorder := httptest.NewRecorder()
+
+		fallbackRouter.ServeHTTP(recorder, request)
+
+		var problem errs.Problem
+		if err := json.Unmarshal(recorder.Body.Bytes(), &problem); err != nil {
+			t.Fatalf("Expected problem body, got %s", recorder.Body.String())
+		}
+		if recorder.Code != test.status || problem.Status != test.status || problem.ErrorCode != test.errorCode ||
+			problem.Type != errs.ProblemTypePrefix+test.errorCode || problem.Instance != test.path {
+			t.Errorf("Unexpected problem for %s %s: %+v", test.method, test.path, problem)
+		}
+		if contentType := recorder.Header().Get("Content-Type"); contentType != "application/problem+json" {
+			t.Errorf("Expected application/problem+json, got %v instead", contentType)
+		}
+	}
+}
+
+/*
+ * function: Test_MalformedListId_should_write_400_for_every_route_of_lists
+ * --------------------
+ * Tests if malformed list ids are answered with a problem with code 400 regardless of route and method, without
+ * calling a service method, while valid ids and routes next to the ids (e.g. /todos/export) are routed as before.
+ * Uses the routes registered by server.Start (see RegisterListRoutes).
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_MalformedListId_should_write_400_for_every_route_of_lists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := ports.NewMockToDoListService(ctrl)
+	service.EXPECT().GetOneListById(gomock.Any(), dummies.DummyListIdHex).Return(&dummies.DummyListValidWithIds, nil).AnyTimes()
+	service.EXPECT().GetAllLists(gomock.Any()).Return(&[]domain.ToDoList{dummies.DummyListValidWithIds}, nil).AnyTimes()
+
+	listRouter := NewRouter()
+	RegisterListRoutes(listRouter, &ToDoListHandlers{service}, &ShareLinkHandlers{ports.NewMockShareLinkService(ctrl)})
+
+	for _, test := range []struct {
+		method string
+		path   string
+		status int
+	}{
+		{http.MethodGet, "/todos/xyz", http.StatusBadRequest},
+		{http.MethodPut, "/todos/xyz", http.StatusBadRequest},
+		{http.MethodDelete, "/todos/xyz", http.StatusBadRequest},
+		{http.MethodGet, "/todos/601be448b9b5e15374b1e84", http.StatusBadRequest},
+		{http.MethodGet, "/todos/xyz/export", http.StatusBadRequest},
+		{http.MethodGet, "/todos/xyz/calendar.ics", http.StatusBadRequest},
+		{http.MethodPut, "/todos/xyz/collaborators/bob", http.StatusBadRequest},
+		{http.MethodDelete, "/todos/xyz/share-links/1", http.StatusBadRequest},
+		{http.MethodGet, "/todos/" + dummies.DummyListIdHex, http.StatusOK},
+		{http.MethodGet, "/todos/" + dummies.DummyListIdHex + "/export?format=markdown", http.StatusOK},
+		{http.MethodGet, "/todos/export?format=markdown", http.StatusOK},
+		{http.MethodPost, "/todos/export", http.StatusMethodNotAllowed},
+		{http.MethodPatch, "/todos/" + dummies.DummyListIdHex, http.StatusMethodNotAllowed},
+		{http.MethodGet, "/todos/" + dummies.DummyListIdHex + "/unknown", http.StatusNotFound},
+	} {
+		request, _ := http.NewRequest(test.method, test.path, nil)
+		recorder := httptest.NewRecorder()
+
+		listRouter.ServeHTTP(recorder, request)
+
+		if recorder.Code != test.status {
+			t.Errorf("Expected code %v for %s %s, got %v instead", test.status, test.method, test.path, recorder.Code)
+			continue
+		}
+		if test.status != http.StatusBadRequest {
+			continue
+		}
+		var problem errs.Problem
+		if err := json.Unmarshal(recorder.Body.Bytes(), &problem); err != nil {
+			t.Fatalf("Expected problem body, got %s", recorder.Body.String())
+		}
+		if problem.ErrorCode != errs.CodeBadRequest || problem.Detail != "ID is invalid" {
+			t.Errorf("Unexpected problem for %s %s: %+v", test.method, test.path, problem)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"github.com/gorilla/mux"
+	"net"
+	"net/http"
+	"strings"
+)
+
+/*
+ * Function: ForwardedFor
+ * --------------------
+ * Instantiates a middleware replacing the remote address of requests sent by a trusted proxy with the address of the
+ * client the proxy forwards them for, so that rate limiting (see clientKey) and logging apply to clients instead of
+ * the proxy. The client is the last address of the X-Forwarded-For header that is not a trusted proxy itself, as
+ * addresses before it may be forged by the client. Requests from other addresses are passed on unchanged.
+ *
+ * trusted: the networks of the trusted proxies (see config.Server.TrustedNetworks)
+ *
+ * returns: a mux.MiddlewareFunc
+ */
+
+func ForwardedFor(trusted []*net.IPNet) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !isTrusted(trusted, hostOf(r.RemoteAddr)) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			forwarded := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+			for i := len(forwarded) - 1; i >= 0; i-- {
+				client := strings.TrimSpace(forwarded[i])
+				if net.ParseIP(client) == nil {
+					break
+				}
+				if !isTrusted(trusted, client) || i == 0 {
+					r = r.WithContext(r.Context())
+					r.RemoteAddr = client
+					break
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+/*
+ * Function: hostOf
+ * --------------------
+ * Strips the port from a remote address.
+ *
+ * address: the address, e.g. "10.0.0.1:4711" or "10.0.0.1"
+ *
+ * returns: the host of the address
+ */
+
+func hostOf(address string) string {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return address
+	}
+	return host
+}
+
+/*
+ * Function: isTrusted
+ * --------------------
+ * Checks whether an IP address belongs to one of the trusted networks.
+ *
+ * trusted: the trusted networks
+ * address: the IP address as string
+ *
+ * returns: true if the address is trusted, false otherwise (including addresses that cannot be parsed)
+ */
+
+func isTrusted(trusted []*net.IPNet, address string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+	for _, network := range trusted {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
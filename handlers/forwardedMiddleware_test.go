@@ -0,0 +1,98 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"github.com/luschnat-ziegler/toDoListAPI/config"
+	"github.com/luschnat-ziegler/toDoListAPI/ratelimit"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+/*
+ * function: Test_ForwardedFor_should_take_client_address_only_from_trusted_proxies
+ * --------------------
+ * Tests if the remote address is replaced by the last untrusted address of X-Forwarded-For for requests of trusted
+ * proxies, while the header is ignored for requests of other addresses and malformed headers are ignored entirely.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ForwardedFor_should_take_client_address_only_from_trusted_proxies(t *testing.T) {
+	trusted, err := config.Server{TrustedProxies: []string{"10.0.0.0/8", "192.0.2.1"}}.TrustedNetworks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var remoteAddr string
+	handler := ForwardedFor(trusted)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		remoteAddr = r.RemoteAddr
+	}))
+
+	for _, test := range []struct {
+		remoteAddr string
+		forwarded  string
+		expected   string
+	}{
+		{"10.0.0.1:1234", "203.0.113.7", "203.0.113.7"},
+		{"10.0.0.1:1234", "198.51.100.1, 203.0.113.7, 10.0.0.2", "203.0.113.7"},
+		{"192.0.2.1:1234", "10.0.0.3, 10.0.0.2", "10.0.0.3"},
+		{"10.0.0.1:1234", "", "10.0.0.1:1234"},
+		{"10.0.0.1:1234", "unknown", "10.0.0.1:1234"},
+		{"198.51.100.9:1234", "203.0.113.7", "198.51.100.9:1234"},
+	} {
+		request, _ := http.NewRequest(http.MethodGet, "/todos", nil)
+		request.RemoteAddr = test.remoteAddr
+		if test.forwarded != "" {
+			request.Header.Set("X-Forwarded-For", test.forwarded)
+		}
+
+		handler.ServeHTTP(httptest.NewRecorder(), request)
+
+		if remoteAddr != test.expected {
+			t.Errorf("Expected %v for %v forwarding %q, got %v instead", test.expected, test.remoteAddr, test.forwarded, remoteAddr)
+		}
+	}
+}
+
+/*
+ * function: Test_RateLimit_behind_ForwardedFor_should_limit_clients_of_trusted_proxy_separately
+ * --------------------
+ * Tests if clients sending requests through the same trusted proxy are limited by their own addresses instead of
+ * sharing the bucket of the proxy.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_RateLimit_behind_ForwardedFor_should_limit_clients_of_trusted_proxy_separately(t *testing.T) {
+	trusted, _ := config.Server{TrustedProxies: []string{"10.0.0.1"}}.TrustedNetworks()
+	limiter := ratelimit.NewLimiter(config.Limit{PerMinute: 60, Burst: 1})
+	handler := ForwardedFor(trusted)(RateLimit(limiter, limiter)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})))
+
+	serve := func(client string) int {
+		request, _ := http.NewRequest(http.MethodGet, "/todos", nil)
+		request.RemoteAddr = "10.0.0.1:1234"
+		request.Header.Set("X-Forwarded-For", client)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		return recorder.Code
+	}
+
+	if code := serve("203.0.113.7"); code != http.StatusOK {
+		t.Errorf("Expected code 200 for first client, got %v instead", code)
+	}
+	if code := serve("203.0.113.8"); code != http.StatusOK {
+		t.Errorf("Expected code 200 for second client, got %v instead", code)
+	}
+	if code := serve("203.0.113.7"); code != http.StatusTooManyRequests {
+		t.Errorf("Expected code 429 for first client exceeding its burst, got %v instead", code)
+	}
+}
@@ -0,0 +1,90 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"context"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"net/http"
+	"sort"
+	"time"
+)
+
+const (
+	statusUp   = "up"
+	statusDown = "down"
+)
+
+type HealthHandlers struct {
+	Checks map[string]func(context.Context) *errs.AppError
+}
+
+type dependencyStatus struct {
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+}
+
+type healthStatus struct {
+	Status string                      `json:"status"`
+	Checks map[string]dependencyStatus `json:"checks,omitempty"`
+}
+
+/*
+ * Method: HealthHandlers.Live
+ * --------------------
+ * To be called by liveness probes. Writes status "up" and code 200 as long as the process is able to serve
+ * requests, without checking any dependency.
+ *
+ * w, r: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
+ *
+ * returns: nothing
+ */
+
+func (hh *HealthHandlers) Live(w http.ResponseWriter, r *http.Request) {
+	writeResponse(w, r, http.StatusOK, healthStatus{Status: statusUp})
+}
+
+/*
+ * Method: HealthHandlers.Ready
+ * --------------------
+ * To be called by readiness probes. Runs every registered check and writes the status and latency of each
+ * dependency to the response body. Writes code 200 if all dependencies are up and code 503 otherwise.
+ *
+ * w, r: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
+ *
+ * returns: nothing
+ */
+
+func (hh *HealthHandlers) Ready(w http.ResponseWriter, r *http.Request) {
+	names := make([]string, 0, len(hh.Checks))
+	for name := range hh.Checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := healthStatus{Status: statusUp, Checks: make(map[string]dependencyStatus)}
+	code := http.StatusOK
+
+	for _, name := range names {
+		start := time.Now()
+		appErr := hh.Checks[name](r.Context())
+		status := dependencyStatus{
+			Status:    statusUp,
+			LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+		}
+		if appErr != nil {
+			status.Status = statusDown
+			status.Error = appErr.Message
+			result.Status = statusDown
+			code = http.StatusServiceUnavailable
+		}
+		result.Checks[name] = status
+	}
+
+	writeResponse(w, r, code, result)
+}
@@ -0,0 +1,126 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+/*
+ * function: Test_HealthHandlers_Live_should_write_status_up
+ * --------------------
+ * Tests if method writes status "up" and status code 200 without running any check.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_HealthHandlers_Live_should_write_status_up(t *testing.T) {
+	hh := HealthHandlers{Checks: map[string]func(context.Context) *errs.AppError{
+		"database": func(context.Context) *errs.AppError {
+			t.Error("Expected no check to run")
+			return nil
+		},
+	}}
+
+	request, _ := http.NewRequest(http.MethodGet, "/healthz", nil)
+	recorder := httptest.NewRecorder()
+
+	hh.Live(recorder, request)
+
+	if recorder.Code != http.StatusOK {
+		t.Errorf("Expected code 200, got %v instead", recorder.Code)
+	}
+
+	if body := recorder.Body.String(); body != "{\"status\":\"up\"}\n" {
+		t.Errorf("Unexpected response body %v", body)
+	}
+}
+
+/*
+ * function: Test_HealthHandlers_Ready_should_write_200_if_all_checks_pass
+ * --------------------
+ * Tests if method writes status "up" for every dependency as well as status code 200 if all checks return nil.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_HealthHandlers_Ready_should_write_200_if_all_checks_pass(t *testing.T) {
+	hh := HealthHandlers{Checks: map[string]func(context.Context) *errs.AppError{
+		"database": func(context.Context) *errs.AppError { return nil },
+	}}
+
+	request, _ := http.NewRequest(http.MethodGet, "/readyz", nil)
+	recorder := httptest.NewRecorder()
+
+	hh.Ready(recorder, request)
+
+	if recorder.Code != http.StatusOK {
+		t.Errorf("Expected code 200, got %v instead", recorder.Code)
+	}
+
+	var result healthStatus
+	if err := json.Unmarshal(recorder.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Could not decode response body: %v", err)
+	}
+
+	if result.Status != statusUp || result.Checks["database"].Status != statusUp {
+		t.Errorf("Expected all statuses to be up, got %+v instead", result)
+	}
+}
+
+/*
+ * function: Test_HealthHandlers_Ready_should_write_503_if_a_check_fails
+ * --------------------
+ * Tests if method writes status "down" and the error message of the failing dependency as well as status code 503
+ * if any check returns a pointer to errs.AppError.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_HealthHandlers_Ready_should_write_503_if_a_check_fails(t *testing.T) {
+	hh := HealthHandlers{Checks: map[string]func(context.Context) *errs.AppError{
+		"database": func(context.Context) *errs.AppError { return errs.NewInternalError("Database unreachable") },
+		"cache":    func(context.Context) *errs.AppError { return nil },
+	}}
+
+	request, _ := http.NewRequest(http.MethodGet, "/readyz", nil)
+	recorder := httptest.NewRecorder()
+
+	hh.Ready(recorder, request)
+
+	if recorder.Code != http.StatusServiceUnavailable {
+		t.Errorf("Expected code 503, got %v instead", recorder.Code)
+	}
+
+	var result healthStatus
+	if err := json.Unmarshal(recorder.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Could not decode response body: %v", err)
+	}
+
+	if result.Status != statusDown {
+		t.Errorf("Expected overall status down, got %v instead", result.Status)
+	}
+
+	if database := result.Checks["database"]; database.Status != statusDown || database.Error != "Database unreachable" {
+		t.Errorf("Unexpected database status %+v", database)
+	}
+
+	if result.Checks["cache"].Status != statusUp {
+		t.Errorf("Expected cache status up, got %v instead", result.Checks["cache"].Status)
+	}
+}
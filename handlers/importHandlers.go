@@ -0,0 +1,174 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/formats"
+	"github.com/luschnat-ziegler/toDoListAPI/logger"
+	"go.uber.org/zap"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+const importFileField = "file"
+
+/*
+ * Method: ToDoListHandlers.Import
+ * --------------------
+ * To be called when lists are to be imported from a CSV, markdown or todo.txt document, sent either as raw request
+ * body or as file (form field "file") of a multipart request. The format is taken from the query parameter "format"
+ * or, if absent, from the file name or content type. All lists are parsed and validated before any of them is
+ * saved: if a line cannot be parsed or a list fails validation, an errs.ImportError listing all problems is written
+ * to the response body and code 400 to the header, and nothing is saved.
+ * Otherwise, every list is saved using the service method and the newly created resources are written to the
+ * response body as JSON and code 201 to the header. If a pointer to an errs.AppError is returned by the service
+ * method, the lists saved so far are deleted again (see rollbackImport) and its message is written to the response
+ * body and its Code to the header, instead.
+ *
+ * w, r: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
+ *
+ * returns: nothing
+ */
+
+func (ah *ToDoListHandlers) Import(w http.ResponseWriter, r *http.Request) {
+
+	format, body, appErr := importSource(r)
+	if appErr != nil {
+		writeError(w, r, appErr)
+		return
+	}
+
+	document, appErr := readBody(body)
+	if appErr != nil {
+		writeError(w, r, appErr)
+		return
+	}
+
+	parsed, parseErrors := format.Parse(bytes.NewReader(document))
+
+	var invalidLists []errs.InvalidList
+	for _, parsedList := range parsed {
+		if validationError := parsedList.List.Validate(); validationError != nil {
+			invalidLists = append(invalidLists, errs.InvalidList{
+				Line:          parsedList.Line,
+				Name:          parsedList.List.Name,
+				InvalidFields: validationError.InvalidFields,
+				Violations:    validationError.Violations,
+			})
+		}
+	}
+
+	if len(parseErrors) > 0 || len(invalidLists) > 0 {
+		importError := errs.NewImportError(parseErrors, invalidLists)
+		writeError(w, r, importError)
+		return
+	}
+
+	if len(parsed) == 0 {
+		appErr := errs.Localized(errs.NewBadRequestError, errs.MessageImportEmpty, "format", format.Name)
+		writeError(w, r, appErr)
+		return
+	}
+
+	savedLists := make([]domain.ToDoList, 0, len(parsed))
+	for _, parsedList := range parsed {
+		savedList, appErr := ah.Service.SaveList(r.Context(), parsedList.List)
+		if appErr != nil {
+			ah.rollbackImport(r.Context(), savedLists)
+			writeError(w, r, appErr)
+			return
+		}
+		savedLists = append(savedLists, *savedList)
+	}
+
+	writeResponse(w, r, http.StatusCreated, savedLists)
+}
+
+/*
+ * Method: ToDoListHandlers.rollbackImport
+ * --------------------
+ * Deletes the lists saved by an import that failed part way, so an import either saves all lists or none. The
+ * deletion is not cut short if the request has been cancelled; lists that cannot be deleted are logged.
+ *
+ * ctx: the context.Context of the request
+ * savedLists: the lists saved by the import so far
+ *
+ * returns: nothing
+ */
+
+func (ah *ToDoListHandlers) rollbackImport(ctx context.Context, savedLists []domain.ToDoList) {
+	ctx = context.WithoutCancel(ctx)
+	for _, savedList := range savedLists {
+		if appErr := ah.Service.DeleteListById(ctx, savedList.Id.Hex()); appErr != nil {
+			logger.FromContext(ctx).Error("Error rolling back import: "+appErr.Message, zap.String("list_id", savedList.Id.Hex()))
+		}
+	}
+}
+
+/*
+ * Function: importSource
+ * --------------------
+ * Resolves the document to be imported and its format. For multipart requests, the part named "file" is used and
+ * its file name and content type serve as fallback for the format. For other requests, the request body is used
+ * and its content type serves as fallback. The query parameter "format" takes precedence in both cases.
+ *
+ * r: a pointer to the http.Request carrying the document.
+ *
+ * returns: the formats.Format, an io.Reader providing the document and nil on success.
+ *          Otherwise, the zero value, nil and a pointer to an errs.AppError (code 400, or code 413 for documents
+ *          exceeding the body limit) are returned.
+ */
+
+func importSource(r *http.Request) (formats.Format, io.Reader, *errs.AppError) {
+	name := r.URL.Query().Get("format")
+	contentType := r.Header.Get("Content-Type")
+	fileName := ""
+	var body io.Reader = r.Body
+
+	if mediaType, _, _ := mime.ParseMediaType(contentType); mediaType == "multipart/form-data" {
+		reader, err := r.MultipartReader()
+		if err != nil {
+			return formats.Format{}, nil, errs.NewBadRequestError("Body parsing error")
+		}
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				return formats.Format{}, nil, errs.Localized(errs.NewBadRequestError, errs.MessageFormField, "field", importFileField)
+			}
+			if err != nil {
+				return formats.Format{}, nil, bodyReadError(err)
+			}
+			if part.FormName() == importFileField {
+				body = part
+				fileName = part.FileName()
+				contentType = part.Header.Get("Content-Type")
+				break
+			}
+		}
+	}
+
+	if name != "" {
+		if format, ok := formats.Lookup(name); ok {
+			return format, body, nil
+		}
+	} else if format, ok := formats.LookupByFileName(fileName); ok {
+		return format, body, nil
+	} else if format, ok := formats.LookupByContentType(contentType); ok {
+		return format, body, nil
+	}
+
+	return formats.Format{}, nil, errs.NewBadRequestError(
+		fmt.Sprintf("Unsupported import format, expected one of: %s", strings.Join(formats.Names(), ", ")),
+	)
+}
@@ -0,0 +1,195 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/testUtils/dummies"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+/*
+ * function: Test_ToDoListHandlers_Import_should_save_lists_parsed_from_raw_body
+ * --------------------
+ * Tests if method saves every list parsed from a raw markdown body using the service method and writes the saved
+ * lists to the response body as well as status code 201.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_Import_should_save_lists_parsed_from_raw_body(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	router.HandleFunc("/todos/import", th.Import)
+	mockDefaultToDoListService.EXPECT().SaveList(gomock.Any(), dummies.DummyListValid).Return(&dummies.DummyListValidWithIds, nil).Times(1)
+
+	request, _ := http.NewRequest(
+		http.MethodPost,
+		"/todos/import",
+		bytes.NewBuffer([]byte(dummies.DummyListValidWithIdsAsMarkdown)),
+	)
+	request.Header.Set("Content-Type", "text/markdown")
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusCreated {
+		t.Errorf("Expected code 201, got %v instead", recorder.Code)
+	}
+
+	resBody := recorder.Body.String()
+	resBody = resBody[:len(resBody)-1]
+
+	if resBody != "["+dummies.DummyListValidWithIdsAsJson+"]" {
+		t.Error("Response body does not match")
+	}
+}
+
+/*
+ * function: Test_ToDoListHandlers_Import_should_save_lists_parsed_from_multipart_file
+ * --------------------
+ * Tests if method reads the document from the multipart form field "file", determines the format from its file
+ * name and saves the parsed lists using the service method.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_Import_should_save_lists_parsed_from_multipart_file(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	router.HandleFunc("/todos/import", th.Import)
+	mockDefaultToDoListService.EXPECT().SaveList(gomock.Any(), dummies.DummyListValid).Return(&dummies.DummyListValidWithIds, nil).Times(1)
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, _ := writer.CreateFormFile("file", "lists.csv")
+	_, _ = part.Write([]byte(dummies.DummyListValidWithIdsAsCSV))
+	_ = writer.Close()
+
+	request, _ := http.NewRequest(http.MethodPost, "/todos/import", &body)
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusCreated {
+		t.Errorf("Expected code 201, got %v instead", recorder.Code)
+	}
+}
+
+/*
+ * function: Test_ToDoListHandlers_Import_should_write_parse_and_validation_errors_without_saving
+ * --------------------
+ * Tests if method writes all parse errors and invalid lists to the response body as well as status code 400 and
+ * does not call the service method if the document contains errors.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_Import_should_write_parse_and_validation_errors_without_saving(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	router.HandleFunc("/todos/import", th.Import)
+
+	request, _ := http.NewRequest(
+		http.MethodPost,
+		"/todos/import?format=markdown",
+		bytes.NewBuffer([]byte(dummies.DummyInvalidImportMarkdown)),
+	)
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("Expected code 400, got %v instead", recorder.Code)
+	}
+
+	resBody := recorder.Body.String()
+	resBody = resBody[:len(resBody)-1]
+
+	if resBody != fmt.Sprintf(dummies.DummyImportErrorAsJSON, "/todos/import") {
+		t.Errorf("Response body does not match, got %v", resBody)
+	}
+}
+
+/*
+ * function: Test_ToDoListHandlers_Import_should_delete_saved_lists_if_saving_fails
+ * --------------------
+ * Tests if method deletes the lists saved so far using the service method if saving a later list fails, and writes
+ * the error returned by the service method.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_Import_should_delete_saved_lists_if_saving_fails(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	router.HandleFunc("/todos/import", th.Import)
+	gomock.InOrder(
+		mockDefaultToDoListService.EXPECT().SaveList(gomock.Any(), gomock.Any()).Return(&dummies.DummyListValidWithIds, nil).Times(1),
+		mockDefaultToDoListService.EXPECT().SaveList(gomock.Any(), gomock.Any()).Return(nil, errs.NewInternalError("Database error")).Times(1),
+		mockDefaultToDoListService.EXPECT().DeleteList(gomock.Any(), dummies.DummyListValidWithIds.Id.Hex()).Return(nil).Times(1),
+	)
+
+	request, _ := http.NewRequest(
+		http.MethodPost,
+		"/todos/import?format=markdown",
+		bytes.NewBuffer([]byte(dummies.DummyListValidWithIdsAsMarkdown+"\n"+dummies.DummyListValidWithIdsAsMarkdown)),
+	)
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusInternalServerError {
+		t.Errorf("Expected code 500, got %v instead", recorder.Code)
+	}
+}
+
+/*
+ * function: Test_ToDoListHandlers_Import_should_write_error_400_if_format_unknown
+ * --------------------
+ * Tests if method writes status code 400 if neither query parameter nor content type identify a format.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_Import_should_write_error_400_if_format_unknown(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	router.HandleFunc("/todos/import", th.Import)
+
+	request, _ := http.NewRequest(http.MethodPost, "/todos/import", bytes.NewBuffer([]byte("{}")))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("Expected code 400, got %v instead", recorder.Code)
+	}
+}
@@ -0,0 +1,481 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/tracing"
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/yaml.v3"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type codec struct {
+	mediaType string
+	aliases   []string
+	marshal   func(interface{}) ([]byte, error)
+	unmarshal func([]byte, interface{}) error
+}
+
+var jsonCodec = codec{
+	mediaType: "application/json",
+	marshal: func(v interface{}) ([]byte, error) {
+		var buffer bytes.Buffer
+		err := json.NewEncoder(&buffer).Encode(v)
+		return buffer.Bytes(), err
+	},
+	unmarshal: decodeJSON,
+}
+
+var codecs = []codec{
+	jsonCodec,
+	{
+		mediaType: "application/yaml",
+		aliases:   []string{"application/x-yaml", "text/yaml", "text/x-yaml"},
+		marshal:   viaJSON(yaml.Marshal),
+		unmarshal: fromJSON(yaml.Unmarshal),
+	},
+	{
+		mediaType: "application/msgpack",
+		aliases:   []string{"application/x-msgpack", "application/vnd.msgpack"},
+		marshal:   viaJSON(msgpack.Marshal),
+		unmarshal: fromJSON(msgpack.Unmarshal),
+	},
+}
+
+/*
+ * Function: codecFor
+ * --------------------
+ * Retrieves the codec registered for a media type (or one of its aliases).
+ *
+ * mediaType: a media type without parameters, e.g. "application/yaml".
+ *
+ * returns: the matching codec and true if the media type is supported. Otherwise, the zero value and false.
+ */
+
+func codecFor(mediaType string) (codec, bool) {
+	for _, c := range codecs {
+		if c.mediaType == mediaType {
+			return c, true
+		}
+		for _, alias := range c.aliases {
+			if alias == mediaType {
+				return c, true
+			}
+		}
+	}
+	return codec{}, false
+}
+
+/*
+ * Function: negotiateCodec
+ * --------------------
+ * Selects the codec for a response based on the Accept header of the request. Media ranges are ordered by their
+ * quality value; wildcards ("*\/*", "application/*") and a missing header select JSON.
+ *
+ * r: a pointer to the http.Request carrying the Accept header.
+ *
+ * returns: the selected codec and true, or the zero value and false if none of the accepted types is supported.
+ */
+
+func negotiateCodec(r *http.Request) (codec, bool) {
+	accept := ""
+	if r != nil {
+		accept = strings.TrimSpace(r.Header.Get("Accept"))
+	}
+	if accept == "" {
+		return jsonCodec, true
+	}
+
+	type mediaRange struct {
+		mediaType string
+		quality   float64
+	}
+	var ranges []mediaRange
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+		if quality > 0 {
+			ranges = append(ranges, mediaRange{mediaType, quality})
+		}
+	}
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+
+	for _, mediaRange := range ranges {
+		if mediaRange.mediaType == "*/*" || mediaRange.mediaType == "application/*" {
+			return jsonCodec, true
+		}
+		if c, ok := codecFor(mediaRange.mediaType); ok {
+			return c, true
+		}
+	}
+	return codec{}, false
+}
+
+/*
+ * Function: negotiateLocale
+ * --------------------
+ * Selects the locale for messages based on the Accept-Language header of the request. Language ranges are ordered
+ * by their quality value and matched by their primary subtag, so that e.g. "de-AT" selects German. The wildcard
+ * "*", a missing header or a header naming no supported language select the default locale.
+ *
+ * r: a pointer to the http.Request carrying the Accept-Language header.
+ *
+ * returns: one of the locales returned by errs.Locales.
+ */
+
+func negotiateLocale(r *http.Request) string {
+	if r == nil {
+		return errs.DefaultLocale
+	}
+
+	type languageRange struct {
+		language string
+		quality  float64
+	}
+	var ranges []languageRange
+	for _, part := range strings.Split(r.Header.Get("Accept-Language"), ",") {
+		fields := strings.Split(part, ";")
+		language := strings.ToLower(strings.TrimSpace(fields[0]))
+		if language == "" {
+			continue
+		}
+		quality := 1.0
+		for _, param := range fields[1:] {
+			if q, ok := strings.CutPrefix(strings.TrimSpace(param), "q="); ok {
+				var err error
+				if quality, err = strconv.ParseFloat(q, 64); err != nil {
+					quality = 0
+				}
+			}
+		}
+		if quality > 0 {
+			ranges = append(ranges, languageRange{strings.SplitN(language, "-", 2)[0], quality})
+		}
+	}
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+
+	locales := errs.Locales()
+	for _, languageRange := range ranges {
+		if languageRange.language == "*" {
+			return errs.DefaultLocale
+		}
+		for _, locale := range locales {
+			if languageRange.language == locale {
+				return locale
+			}
+		}
+	}
+	return errs.DefaultLocale
+}
+
+type decodeError struct {
+	message  string
+	field    string
+	offset   int64
+	expected string
+}
+
+/*
+ * Method: decodeError.Error
+ * --------------------
+ * Implements the error interface.
+ *
+ * returns: the message of the decodeError
+ */
+
+func (err *decodeError) Error() string {
+	return err.message
+}
+
+/*
+ * Function: decodeBody
+ * --------------------
+ * Decodes the request body into v using the codec matching the Content-Type header of the request. Requests
+ * without Content-Type are decoded as JSON. Decoding is strict: unknown fields and data following the value are
+ * rejected (see decodeJSON). Decoding is traced in a span of its own.
+ *
+ * r: a pointer to the http.Request whose body is to be decoded.
+ * v: a pointer to the value to decode into.
+ *
+ * returns: nil on success. Otherwise, a pointer to an errs.BodyError with code 415 for unsupported content types,
+ *          code 413 for bodies exceeding the limit (see LimitBody) or code 400 for bodies that cannot be decoded,
+ *          naming field, offset and expected type where known.
+ */
+
+func decodeBody(r *http.Request, v interface{}) *errs.BodyError {
+	_, span := tracing.Start(r.Context(), "decodeBody")
+	defer span.End()
+
+	c := jsonCodec
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		var ok bool
+		if c, ok = codecFor(mediaType); err != nil || !ok {
+			return &errs.BodyError{AppError: *errs.Localized(errs.NewUnsupportedMediaTypeError, errs.MessageContentType, "type", contentType, "types", strings.Join(mediaTypes(), ", "))}
+		}
+	}
+
+	body, appErr := readBody(r.Body)
+	if appErr != nil {
+		return &errs.BodyError{AppError: *appErr}
+	}
+	if err := c.unmarshal(body, v); err != nil {
+		var decodeErr *decodeError
+		if errors.As(err, &decodeErr) {
+			return errs.NewBodyError("Body parsing error: "+decodeErr.message, decodeErr.field, decodeErr.offset, decodeErr.expected)
+		}
+		return errs.NewBodyError("Body parsing error: "+err.Error(), "", 0, "")
+	}
+	return nil
+}
+
+/*
+ * Function: readBody
+ * --------------------
+ * Reads a request body completely.
+ *
+ * body: the io.Reader of the body, limited by http.MaxBytesReader (see LimitBody).
+ *
+ * returns: the body and nil on success. Otherwise, nil and a pointer to an errs.AppError (see bodyReadError).
+ */
+
+func readBody(body io.Reader) ([]byte, *errs.AppError) {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, bodyReadError(err)
+	}
+	return data, nil
+}
+
+/*
+ * Function: bodyReadError
+ * --------------------
+ * Converts an error returned while reading a request body into an errs.AppError.
+ *
+ * err: the error returned by the reader.
+ *
+ * returns: a pointer to an errs.AppError with code 413 if the body exceeds the limit (see LimitBody), code 400
+ *          otherwise.
+ */
+
+func bodyReadError(err error) *errs.AppError {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return errs.Localized(errs.NewRequestEntityTooLargeError, errs.MessageBodyLimit, "limit", strconv.FormatInt(maxBytesErr.Limit, 10))
+	}
+	return errs.NewBadRequestError("Body parsing error")
+}
+
+/*
+ * Function: decodeJSON
+ * --------------------
+ * Decodes a single JSON value into v, rejecting unknown fields and any data but whitespace following the value.
+ *
+ * data: the JSON document.
+ * v: a pointer to the value to decode into.
+ *
+ * returns: nil on success. Otherwise, a pointer to a decodeError naming the offending field, the byte offset and
+ *          the expected JSON type where known.
+ */
+
+func decodeJSON(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	err := decoder.Decode(v)
+	if err == nil {
+		if _, err := decoder.Token(); err != io.EOF {
+			return &decodeError{message: "unexpected data after JSON value", offset: decoder.InputOffset()}
+		}
+		return nil
+	}
+
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		return &decodeError{message: "invalid JSON: " + syntaxErr.Error(), offset: syntaxErr.Offset}
+	case errors.As(err, &typeErr):
+		expected := jsonType(typeErr.Type)
+		return &decodeError{
+			message:  "field " + typeErr.Field + " must be " + expected + ", got " + typeErr.Value,
+			field:    typeErr.Field,
+			offset:   typeErr.Offset,
+			expected: expected,
+		}
+	case errors.Is(err, io.EOF):
+		return &decodeError{message: "body is empty"}
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		return &decodeError{message: "unexpected end of JSON input", offset: int64(len(data))}
+	}
+	if name, found := strings.CutPrefix(err.Error(), "json: unknown field "); found {
+		field, _ := strconv.Unquote(name)
+		offset := decoder.InputOffset()
+		if index := bytes.LastIndex(data[:offset], []byte(name)); index >= 0 {
+			offset = int64(index)
+		}
+		return &decodeError{message: "unknown field " + name, field: field, offset: offset}
+	}
+	return &decodeError{message: err.Error(), offset: decoder.InputOffset()}
+}
+
+/*
+ * Function: jsonType
+ * --------------------
+ * Names the JSON type a Go type is decoded from, so that clients are not confronted with Go type names.
+ *
+ * t: the reflect.Type of the target.
+ *
+ * returns: "string", "number", "boolean", "array" or "object".
+ */
+
+func jsonType(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8,
+		reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	}
+	return "object"
+}
+
+/*
+ * Function: mediaTypes
+ * --------------------
+ * Lists the media types of all supported codecs.
+ *
+ * returns: a slice of media types.
+ */
+
+func mediaTypes() []string {
+	names := make([]string, 0, len(codecs))
+	for _, c := range codecs {
+		names = append(names, c.mediaType)
+	}
+	return names
+}
+
+/*
+ * Function: viaJSON
+ * --------------------
+ * Wraps a marshal function so that values are converted into their JSON representation (respecting json tags,
+ * custom JSON marshalers such as primitive.ObjectID and time.Time) before being marshalled.
+ *
+ * marshal: the marshal function of the target format.
+ *
+ * returns: the wrapped marshal function.
+ */
+
+func viaJSON(marshal func(interface{}) ([]byte, error)) func(interface{}) ([]byte, error) {
+	return func(v interface{}) ([]byte, error) {
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.UseNumber()
+		var generic interface{}
+		if err := decoder.Decode(&generic); err != nil {
+			return nil, err
+		}
+		return marshal(normalizeNumbers(generic))
+	}
+}
+
+/*
+ * Function: fromJSON
+ * --------------------
+ * Wraps an unmarshal function so that data is unmarshalled into generic values first and then decoded into the
+ * target via its JSON representation (see decodeJSON), so that the same field names and rules apply as for JSON
+ * bodies. Offsets of decoding errors refer to the JSON representation and are therefore dropped.
+ *
+ * unmarshal: the unmarshal function of the source format.
+ *
+ * returns: the wrapped unmarshal function.
+ */
+
+func fromJSON(unmarshal func([]byte, interface{}) error) func([]byte, interface{}) error {
+	return func(data []byte, v interface{}) error {
+		var generic interface{}
+		if err := unmarshal(data, &generic); err != nil {
+			return err
+		}
+		raw, err := json.Marshal(generic)
+		if err != nil {
+			return err
+		}
+		if err := decodeJSON(raw, v); err != nil {
+			var decodeErr *decodeError
+			if errors.As(err, &decodeErr) {
+				decodeErr.offset = 0
+			}
+			return err
+		}
+		return nil
+	}
+}
+
+/*
+ * Function: normalizeNumbers
+ * --------------------
+ * Recursively converts json.Number values into int64 (if integral) or float64, so that numbers keep their type in
+ * formats distinguishing integers from floats.
+ *
+ * v: a generic value as decoded by encoding/json with UseNumber.
+ *
+ * returns: the converted value.
+ */
+
+func normalizeNumbers(v interface{}) interface{} {
+	switch value := v.(type) {
+	case json.Number:
+		if i, err := value.Int64(); err == nil {
+			return i
+		}
+		f, _ := value.Float64()
+		return f
+	case map[string]interface{}:
+		for key, element := range value {
+			value[key] = normalizeNumbers(element)
+		}
+	case []interface{}:
+		for i, element := range value {
+			value[i] = normalizeNumbers(element)
+		}
+	}
+	return v
+}
@@ -0,0 +1,308 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/golang/mock/gomock"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/testUtils/dummies"
+	"github.com/vmihailenco/msgpack/v5"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+/*
+ * function: Test_ToDoListHandlers_GetOne_should_write_yaml_if_accepted
+ * --------------------
+ * Tests if method writes the list as YAML with matching content type if YAML is preferred in the Accept header.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_GetOne_should_write_yaml_if_accepted(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	router.HandleFunc("/todos/{id}", th.GetOne)
+	mockDefaultToDoListService.EXPECT().GetOneListById(gomock.Any(), "test_id").Return(&dummies.DummyListValidWithIds, nil).Times(1)
+
+	request, _ := http.NewRequest(http.MethodGet, "/todos/test_id", nil)
+	request.Header.Set("Accept", "application/json;q=0.5, application/yaml")
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusOK {
+		t.Errorf("Expected code 200, got %v instead", recorder.Code)
+	}
+
+	if contentType := recorder.Header().Get("Content-Type"); contentType != "application/yaml" {
+		t.Errorf("Expected application/yaml, got %v instead", contentType)
+	}
+
+	if recorder.Body.String() != dummies.DummyListValidWithIdsAsYAML {
+		t.Errorf("Response body does not match, got %q", recorder.Body.String())
+	}
+}
+
+/*
+ * function: Test_ToDoListHandlers_GetOne_should_write_msgpack_if_accepted
+ * --------------------
+ * Tests if method writes the list as MessagePack with matching content type if MessagePack is accepted.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_GetOne_should_write_msgpack_if_accepted(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	router.HandleFunc("/todos/{id}", th.GetOne)
+	mockDefaultToDoListService.EXPECT().GetOneListById(gomock.Any(), "test_id").Return(&dummies.DummyListValidWithIds, nil).Times(1)
+
+	request, _ := http.NewRequest(http.MethodGet, "/todos/test_id", nil)
+	request.Header.Set("Accept", "application/msgpack")
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	if contentType := recorder.Header().Get("Content-Type"); contentType != "application/msgpack" {
+		t.Errorf("Expected application/msgpack, got %v instead", contentType)
+	}
+
+	var decoded map[string]interface{}
+	if err := msgpack.Unmarshal(recorder.Body.Bytes(), &decoded); err != nil {
+		t.Fatalf("Expected MessagePack body, got error %v", err)
+	}
+	if decoded["id"] != "601be448b9b5e15374b1e842" || decoded["name"] != "Dummy List Name" {
+		t.Errorf("Response body does not match, got %v", decoded)
+	}
+}
+
+/*
+ * function: Test_ToDoListHandlers_GetOne_should_write_406_if_no_accepted_type_supported
+ * --------------------
+ * Tests if method writes status code 406 as problem details if none of the types in the Accept header is supported.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_GetOne_should_write_406_if_no_accepted_type_supported(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	router.HandleFunc("/todos/{id}", th.GetOne)
+	mockDefaultToDoListService.EXPECT().GetOneListById(gomock.Any(), "test_id").Return(&dummies.DummyListValidWithIds, nil).Times(1)
+
+	request, _ := http.NewRequest(http.MethodGet, "/todos/test_id", nil)
+	request.Header.Set("Accept", "text/html")
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusNotAcceptable {
+		t.Errorf("Expected code 406, got %v instead", recorder.Code)
+	}
+
+	if contentType := recorder.Header().Get("Content-Type"); contentType != "application/problem+json" {
+		t.Errorf("Expected application/problem+json, got %v instead", contentType)
+	}
+}
+
+/*
+ * function: Test_ToDoListHandlers_Save_should_decode_yaml_body
+ * --------------------
+ * Tests if method decodes a YAML request body according to its Content-Type header.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_Save_should_decode_yaml_body(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	router.HandleFunc("/todos", th.Save)
+	mockDefaultToDoListService.EXPECT().SaveList(gomock.Any(), dummies.DummyListValid).Return(&dummies.DummyListValidWithIds, nil).Times(1)
+
+	request, _ := http.NewRequest(http.MethodPost, "/todos", bytes.NewBuffer([]byte(dummies.DummyValidSaveListRequestAsYAML)))
+	request.Header.Set("Content-Type", "application/yaml")
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusCreated {
+		t.Errorf("Expected code 201, got %v instead", recorder.Code)
+	}
+}
+
+/*
+ * function: Test_ToDoListHandlers_Save_should_write_415_if_content_type_unsupported
+ * --------------------
+ * Tests if method writes status code 415 and does not call the service method if the Content-Type of the request
+ * body is not supported.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_Save_should_write_415_if_content_type_unsupported(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	router.HandleFunc("/todos", th.Save)
+
+	request, _ := http.NewRequest(http.MethodPost, "/todos", bytes.NewBuffer([]byte("<list/>")))
+	request.Header.Set("Content-Type", "application/xml")
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusUnsupportedMediaType {
+		t.Errorf("Expected code 415, got %v instead", recorder.Code)
+	}
+}
+
+/*
+ * function: Test_decodeBody_should_reject_unknown_fields_trailing_data_and_wrong_types_precisely
+ * --------------------
+ * Tests if decoding errors name the offending field, the offset and the expected type where applicable, for JSON
+ * as well as YAML bodies.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_decodeBody_should_reject_unknown_fields_trailing_data_and_wrong_types_precisely(t *testing.T) {
+	tests := []struct {
+		contentType string
+		body        string
+		expected    errs.BodyError
+	}{
+		{"application/json", `{"name":"list","color":"red"}`, errs.BodyError{Field: "color", Offset: 15}},
+		{"application/json", `{"name":"list"} {}`, errs.BodyError{Offset: 17}},
+		{"application/json", `{"name":"list","tasks":[{"name":1}]}`, errs.BodyError{Field: "tasks.0.name", Offset: 33, Expected: "string"}},
+		{"application/json", ``, errs.BodyError{}},
+		{"application/yaml", "name: list\ndone: true\n", errs.BodyError{Field: "done"}},
+	}
+
+	for _, test := range tests {
+		request, _ := http.NewRequest(http.MethodPost, "/todos", bytes.NewBufferString(test.body))
+		request.Header.Set("Content-Type", test.contentType)
+		var list domain.ToDoList
+
+		bodyErr := decodeBody(request, &list)
+
+		if bodyErr == nil {
+			t.Errorf("Expected error for %q, got nil instead", test.body)
+			continue
+		}
+		if bodyErr.Code != http.StatusBadRequest || bodyErr.Field != test.expected.Field ||
+			bodyErr.Offset != test.expected.Offset || bodyErr.Expected != test.expected.Expected {
+			t.Errorf("Unexpected error for %q: %+v", test.body, bodyErr)
+		}
+	}
+}
+
+/*
+ * function: Test_negotiateLocale_should_select_supported_language_by_quality
+ * --------------------
+ * Tests if the locale is selected from the Accept-Language header by quality value and primary subtag, falling back
+ * to English.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_negotiateLocale_should_select_supported_language_by_quality(t *testing.T) {
+	tests := map[string]string{
+		"":                         "en",
+		"de":                       "de",
+		"de-AT, en;q=0.5":          "de",
+		"fr, en;q=0.4, de;q=0.8":   "de",
+		"EN-us":                    "en",
+		"de;q=0, fr":               "en",
+		"*, de;q=0.5":              "en",
+		"es, it;q=0.9, pt-BR;q=.8": "en",
+	}
+
+	for header, expected := range tests {
+		request, _ := http.NewRequest(http.MethodGet, "/todos", nil)
+		request.Header.Set("Accept-Language", header)
+
+		if locale := negotiateLocale(request); locale != expected {
+			t.Errorf("Expected %s for %q, got %s instead", expected, header, locale)
+		}
+	}
+}
+
+/*
+ * function: Test_ToDoListHandlers_should_write_german_problems_if_accepted
+ * --------------------
+ * Tests if title, detail and validation messages of problems are translated into German, keeping the parameters of
+ * the message, if German is preferred in the Accept-Language header.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_should_write_german_problems_if_accepted(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	router.HandleFunc("/todos", th.Save)
+	router.HandleFunc("/todos/{id}", th.GetOne)
+	notFound := errs.Localized(errs.NewNotFoundError, errs.MessageListNotFound, "id", "test_id")
+	mockDefaultToDoListService.EXPECT().GetOneListById(gomock.Any(), "test_id").Return(nil, notFound).Times(1)
+
+	request, _ := http.NewRequest(http.MethodGet, "/todos/test_id", nil)
+	request.Header.Set("Accept-Language", "de-DE, en;q=0.5")
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	var problem errs.Problem
+	if err := json.Unmarshal(recorder.Body.Bytes(), &problem); err != nil {
+		t.Fatalf("Expected problem body, got %s", recorder.Body.String())
+	}
+	if problem.Title != "Ressource nicht gefunden" || problem.Detail != "Keine Liste mit der ID test_id gefunden" {
+		t.Errorf("Expected German title and detail, got %q and %q instead", problem.Title, problem.Detail)
+	}
+	if language := recorder.Header().Get("Content-Language"); language != "de" {
+		t.Errorf("Expected Content-Language de, got %v instead", language)
+	}
+
+	request, _ = http.NewRequest(http.MethodPost, "/todos", bytes.NewBuffer([]byte(dummies.DummyInvalidSaveListRequestAsJSON)))
+	request.Header.Set("Accept-Language", "de")
+	recorder = httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	problem = errs.Problem{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &problem); err != nil {
+		t.Fatalf("Expected problem body, got %s", recorder.Body.String())
+	}
+	if problem.Detail != "Die Anfrage enthält ungültige Felder" || len(problem.Violations) != 1 ||
+		problem.Violations[0].Message != "ist erforderlich" || problem.InvalidFields["name"] != "required" {
+		t.Errorf("Expected German validation messages, got %+v instead", problem)
+	}
+}
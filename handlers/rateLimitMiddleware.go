@@ -0,0 +1,92 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/luschnat-ziegler/toDoListAPI/auth"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/logger"
+	"github.com/luschnat-ziegler/toDoListAPI/ratelimit"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+/*
+ * Function: RateLimit
+ * --------------------
+ * Instantiates a middleware limiting the requests per client (see clientKey). Safe methods (GET, HEAD, OPTIONS)
+ * take a token from the reads limiter, all other methods from the writes limiter. Every response carries the
+ * RateLimit-Limit, RateLimit-Remaining and RateLimit-Reset headers; requests exceeding the limit are rejected with
+ * code 429 and a Retry-After header. To be registered after Authenticate, so that authenticated clients are
+ * limited by subject instead of address. Registered before Authenticate, it limits by address only and thereby
+ * also counts requests rejected for missing or invalid credentials.
+ *
+ * reads: a pointer to the ratelimit.Limiter for safe methods
+ * writes: a pointer to the ratelimit.Limiter for all other methods
+ *
+ * returns: a mux.MiddlewareFunc
+ */
+
+func RateLimit(reads *ratelimit.Limiter, writes *ratelimit.Limiter) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			limiter := writes
+			if r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions {
+				limiter = reads
+			}
+
+			decision := limiter.Allow(clientKey(r))
+			w.Header().Set("RateLimit-Limit", strconv.Itoa(decision.Limit))
+			w.Header().Set("RateLimit-Remaining", strconv.Itoa(decision.Remaining))
+			w.Header().Set("RateLimit-Reset", seconds(decision.Reset))
+
+			if !decision.Allowed {
+				logger.FromContext(r.Context()).Warn("Rate limit exceeded")
+				w.Header().Set("Retry-After", seconds(decision.RetryAfter))
+				appErr := errs.Localized(errs.NewTooManyRequestsError, errs.MessageRetryLater, "seconds", seconds(decision.RetryAfter))
+				writeError(w, r, appErr)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+/*
+ * Function: clientKey
+ * --------------------
+ * Identifies the client of a request for rate limiting: by the subject of the authenticated principal (a user or
+ * the name of an API key), or by the remote IP address for unauthenticated requests. Behind a reverse proxy, the
+ * remote address is the one of the proxy unless the proxy is trusted (see ForwardedFor).
+ *
+ * r: a pointer to the http.Request
+ *
+ * returns: the client key as string
+ */
+
+func clientKey(r *http.Request) string {
+	if principal, ok := auth.PrincipalFromContext(r.Context()); ok {
+		return principal.Method + ":" + principal.Subject
+	}
+	return "ip:" + hostOf(r.RemoteAddr)
+}
+
+/*
+ * Function: seconds
+ * --------------------
+ * Formats a duration as whole seconds for rate limit headers.
+ *
+ * duration: the time.Duration
+ *
+ * returns: the number of seconds as string
+ */
+
+func seconds(duration time.Duration) string {
+	return strconv.Itoa(int(duration / time.Second))
+}
@@ -0,0 +1,157 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/luschnat-ziegler/toDoListAPI/auth"
+	"github.com/luschnat-ziegler/toDoListAPI/config"
+	"github.com/luschnat-ziegler/toDoListAPI/ratelimit"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+/*
+ * function: rateLimitedRouter
+ * --------------------
+ * Instantiates a router serving /todos behind the RateLimit middleware with a burst of one read and two writes.
+ * Requests carrying an X-Subject header are treated as authenticated by that subject.
+ *
+ * Returns: a pointer to the mux.Router
+ */
+
+func rateLimitedRouter() *mux.Router {
+	rateLimitedRouter := mux.NewRouter()
+	rateLimitedRouter.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if subject := r.Header.Get("X-Subject"); subject != "" {
+				r = r.WithContext(auth.WithPrincipal(r.Context(), auth.Principal{Subject: subject, Method: auth.MethodAPIKey}))
+			}
+			next.ServeHTTP(w, r)
+		})
+	})
+	rateLimitedRouter.Use(RateLimit(
+		ratelimit.NewLimiter(config.Limit{PerMinute: 60, Burst: 1}),
+		ratelimit.NewLimiter(config.Limit{PerMinute: 60, Burst: 2}),
+	))
+	rateLimitedRouter.HandleFunc("/todos", func(w http.ResponseWriter, r *http.Request) {})
+	return rateLimitedRouter
+}
+
+/*
+ * function: serveRateLimited
+ * --------------------
+ * Serves a request from a fixed remote address, optionally authenticated by a subject.
+ *
+ * handler: the http.Handler serving the request
+ * method: the http method of the request
+ * subject: the subject the request is authenticated by, or the empty string
+ *
+ * Returns: a pointer to the httptest.ResponseRecorder
+ */
+
+func serveRateLimited(handler http.Handler, method string, subject string) *httptest.ResponseRecorder {
+	request, _ := http.NewRequest(method, "/todos", nil)
+	request.RemoteAddr = "192.0.2.1:1234"
+	if subject != "" {
+		request.Header.Set("X-Subject", subject)
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+/*
+ * function: Test_RateLimit_should_reject_requests_exceeding_limit_with_429
+ * --------------------
+ * Tests if requests within the burst pass with rate limit headers and the next request is rejected with code 429
+ * and a Retry-After header.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_RateLimit_should_reject_requests_exceeding_limit_with_429(t *testing.T) {
+	handler := rateLimitedRouter()
+
+	first := serveRateLimited(handler, http.MethodPost, "")
+	if first.Code != http.StatusOK || first.Header().Get("RateLimit-Limit") != "2" || first.Header().Get("RateLimit-Remaining") != "1" {
+		t.Errorf("Expected code 200 with limit 2 and 1 remaining, got %v and headers %v", first.Code, first.Header())
+	}
+	serveRateLimited(handler, http.MethodPost, "")
+
+	rejected := serveRateLimited(handler, http.MethodPost, "")
+	if rejected.Code != http.StatusTooManyRequests {
+		t.Errorf("Expected code 429, got %v instead", rejected.Code)
+	}
+	if retryAfter := rejected.Header().Get("Retry-After"); retryAfter != "1" {
+		t.Errorf("Expected Retry-After 1, got %q instead", retryAfter)
+	}
+}
+
+/*
+ * function: Test_RateLimit_should_limit_groups_and_clients_separately
+ * --------------------
+ * Tests if reads are not limited by exhausted writes and authenticated subjects are not limited by requests from
+ * the same address.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_RateLimit_should_limit_groups_and_clients_separately(t *testing.T) {
+	handler := rateLimitedRouter()
+	serveRateLimited(handler, http.MethodPost, "")
+	serveRateLimited(handler, http.MethodPost, "")
+
+	if recorder := serveRateLimited(handler, http.MethodGet, ""); recorder.Code != http.StatusOK {
+		t.Errorf("Expected read to pass, got code %v instead", recorder.Code)
+	}
+	if recorder := serveRateLimited(handler, http.MethodPost, "alice"); recorder.Code != http.StatusOK {
+		t.Errorf("Expected write of alice to pass, got code %v instead", recorder.Code)
+	}
+}
+
+/*
+ * function: Test_RateLimit_before_Authenticate_should_limit_rejected_credentials_by_address
+ * --------------------
+ * Tests if a RateLimit registered before Authenticate (as done by server.Start) counts requests rejected with code
+ * 401, so that guessing credentials is throttled per address, while requests from other addresses are unaffected.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_RateLimit_before_Authenticate_should_limit_rejected_credentials_by_address(t *testing.T) {
+	authenticator, _ := auth.NewAuthenticator(config.Auth{APIKeys: map[string]string{"ci": "key-123"}})
+	authLimiter := ratelimit.NewLimiter(config.Limit{PerMinute: 60, Burst: 2})
+	guardedRouter := mux.NewRouter()
+	guardedRouter.Use(RateLimit(authLimiter, authLimiter), Authenticate(authenticator))
+	guardedRouter.HandleFunc("/todos", func(w http.ResponseWriter, r *http.Request) {})
+
+	serve := func(remoteAddr string, key string) int {
+		request, _ := http.NewRequest(http.MethodGet, "/todos", nil)
+		request.RemoteAddr = remoteAddr
+		request.Header.Set(auth.APIKeyHeader, key)
+		recorder := httptest.NewRecorder()
+		guardedRouter.ServeHTTP(recorder, request)
+		return recorder.Code
+	}
+
+	for i, expected := range []int{http.StatusUnauthorized, http.StatusUnauthorized, http.StatusTooManyRequests} {
+		if code := serve("192.0.2.1:1234", "guess"); code != expected {
+			t.Errorf("Expected code %v for guess %d, got %v instead", expected, i+1, code)
+		}
+	}
+	if code := serve("192.0.2.2:1234", "key-123"); code != http.StatusOK {
+		t.Errorf("Expected code 200 for another address, got %v instead", code)
+	}
+}
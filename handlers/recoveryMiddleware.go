@@ -0,0 +1,90 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/logger"
+	"go.uber.org/zap"
+	"net/http"
+	"runtime/debug"
+)
+
+type headerRecorder struct {
+	http.ResponseWriter
+	wroteHeader bool
+}
+
+/*
+ * Method: headerRecorder.WriteHeader
+ * --------------------
+ * Remembers that the header has been written before passing the status code on to the wrapped http.ResponseWriter.
+ *
+ * code: the status code of the response
+ *
+ * returns: nothing
+ */
+
+func (hr *headerRecorder) WriteHeader(code int) {
+	hr.wroteHeader = true
+	hr.ResponseWriter.WriteHeader(code)
+}
+
+/*
+ * Method: headerRecorder.Write
+ * --------------------
+ * Remembers that the header has been written (implicitly with code 200) before passing the bytes on to the wrapped
+ * http.ResponseWriter.
+ *
+ * p: the bytes to be written
+ *
+ * returns: the number of bytes written and an error if writing failed
+ */
+
+func (hr *headerRecorder) Write(p []byte) (int, error) {
+	hr.wroteHeader = true
+	return hr.ResponseWriter.Write(p)
+}
+
+/*
+ * Function: Recover
+ * --------------------
+ * Instantiates a middleware recovering from panics of the wrapped handler. The panic value and the stack trace are
+ * logged by the request-scoped logger (carrying the request id, see logger.Middleware) and the request is answered
+ * with a problem with code 500, unless the header has already been written. Panics with http.ErrAbortHandler are
+ * passed on, since they are meant to abort the response silently.
+ *
+ * returns: a mux.MiddlewareFunc
+ */
+
+func Recover() mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			recorder := &headerRecorder{ResponseWriter: w}
+			defer func() {
+				v := recover()
+				if v == nil {
+					return
+				}
+				if v == http.ErrAbortHandler {
+					panic(v)
+				}
+
+				logger.FromContext(r.Context()).Error("Panic serving request",
+					zap.String("panic", fmt.Sprint(v)),
+					zap.ByteString("stack", debug.Stack()),
+				)
+				if !recorder.wroteHeader {
+					writeError(w, r, errs.NewInternalError("Internal server error"))
+				}
+			}()
+			next.ServeHTTP(recorder, r)
+		})
+	}
+}
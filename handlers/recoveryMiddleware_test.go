@@ -0,0 +1,120 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"github.com/luschnat-ziegler/toDoListAPI/logger"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+/*
+ * function: Test_Recover_should_write_500_and_log_stack_with_request_id
+ * --------------------
+ * Tests if a panicking handler is answered with a problem with code 500 and the panic is logged with the stack
+ * trace and the request id.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Recover_should_write_500_and_log_stack_with_request_id(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	defer logger.ReplaceCore(core)()
+
+	handler := logger.Middleware(Recover()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	})))
+
+	request, _ := http.NewRequest(http.MethodGet, "/todos", nil)
+	request.Header.Set(logger.RequestIDHeader, "req-1")
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusInternalServerError {
+		t.Errorf("Expected code 500, got %v instead", recorder.Code)
+	}
+	if contentType := recorder.Header().Get("Content-Type"); contentType != problemMediaType {
+		t.Errorf("Expected %s, got %v instead", problemMediaType, contentType)
+	}
+	if !strings.Contains(recorder.Body.String(), `"code":"internal_error"`) {
+		t.Errorf("Expected internal_error problem, got %s instead", recorder.Body.String())
+	}
+
+	entries := logs.FilterMessage("Panic serving request").All()
+	if len(entries) != 1 {
+		t.Fatalf("Expected one panic log entry, got %v instead", len(entries))
+	}
+	fields := entries[0].ContextMap()
+	if fields["request_id"] != "req-1" || fields["panic"] != "boom" {
+		t.Errorf("Expected request id and panic value to be logged, got %v instead", fields)
+	}
+	if stack, _ := fields["stack"].(string); !strings.Contains(stack, "recoveryMiddleware") {
+		t.Errorf("Expected stack trace to be logged, got %q instead", stack)
+	}
+}
+
+/*
+ * function: Test_Recover_should_not_rewrite_header_already_written
+ * --------------------
+ * Tests if a panic after the header has been written keeps the status code of the partial response instead of
+ * writing a second header.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Recover_should_not_rewrite_header_already_written(t *testing.T) {
+	defer logger.ReplaceCore(zapcore.NewNopCore())()
+
+	handler := Recover()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		panic("boom")
+	}))
+
+	request, _ := http.NewRequest(http.MethodGet, "/todos", nil)
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusAccepted || recorder.Body.Len() != 0 {
+		t.Errorf("Expected code 202 and no body, got %v and %q instead", recorder.Code, recorder.Body.String())
+	}
+}
+
+/*
+ * function: Test_writeResponse_should_write_500_if_encoding_fails
+ * --------------------
+ * Tests if data that cannot be encoded is answered with a problem with code 500 instead of the intended status code.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_writeResponse_should_write_500_if_encoding_fails(t *testing.T) {
+	defer logger.ReplaceCore(zapcore.NewNopCore())()
+
+	request, _ := http.NewRequest(http.MethodGet, "/todos", nil)
+	recorder := httptest.NewRecorder()
+
+	writeResponse(recorder, request, http.StatusOK, map[string]interface{}{"unsupported": make(chan int)})
+
+	if recorder.Code != http.StatusInternalServerError {
+		t.Errorf("Expected code 500, got %v instead", recorder.Code)
+	}
+	if contentType := recorder.Header().Get("Content-Type"); contentType != problemMediaType {
+		t.Errorf("Expected %s, got %v instead", problemMediaType, contentType)
+	}
+}
@@ -13,20 +13,36 @@ import "net/http"
  * --------------------
  * To be called for requests at root. Writes response with API info.
  *
- * w, _: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
+ * w, r: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
  *
  * returns: nothing
  */
 
-func GetInfo(w http.ResponseWriter, _ *http.Request) {
+func GetInfo(w http.ResponseWriter, r *http.Request) {
 
 	apiInfo := map[string]string{
-		"1. GET /todos":         "Returns an array of all todo lists",
-		"2. POST /todos":        "Creates and saves new todo, returns the newly created resource",
-		"3. GET /todos/{id}":    "Returns the todo list with the provided id, if existing",
-		"4. PUT /todos/{id}":    "Overwrites the todo list with the provided id (if existing) with the provided new list.",
-		"5. DELETE /todos/{id}": "Deletes the todo list with the provided id, if existing",
+		"1. GET /todos":                                  "Returns an array of all todo lists",
+		"2. POST /todos":                                 "Creates and saves new todo, returns the newly created resource",
+		"3. GET /todos/{id}":                             "Returns the todo list with the provided id, if existing",
+		"4. PUT /todos/{id}":                             "Overwrites the todo list with the provided id (if existing) with the provided new list.",
+		"5. DELETE /todos/{id}":                          "Deletes the todo list with the provided id, if existing",
+		"6. GET /todos/export":                           "Returns all todo lists as a file (query parameter format: csv, markdown or todotxt)",
+		"7. GET /todos/{id}/export":                      "Returns the todo list with the provided id as a file (query parameter format: csv, markdown or todotxt)",
+		"8. POST /todos/import":                          "Creates and saves the todo lists contained in a csv, markdown or todotxt document, returns the newly created resources",
+		"9. GET /calendar.ics":                           "Returns the tasks of all todo lists that have a due date as iCalendar feed",
+		"10. GET /todos/{id}/calendar.ics":               "Returns the tasks of the todo list with the provided id that have a due date as iCalendar feed",
+		"11. PUT /todos/{id}/collaborators/{subject}":    "Shares the todo list with the provided id with a subject or changes its role (viewer, editor or owner), owners only",
+		"12. DELETE /todos/{id}/collaborators/{subject}": "Stops sharing the todo list with the provided id with a subject, owners only",
+		"13. GET /workspaces":                            "Returns an array of all workspaces the caller owns or is a member of",
+		"14. POST /workspaces":                           "Creates a new workspace owned by the caller, returns the newly created resource",
+		"15. GET /workspaces/{id}":                       "Returns the workspace with the provided id, if the caller belongs to it",
+		"16. PUT /workspaces/{id}":                       "Overwrites name and members of the workspace with the provided id, owners only",
+		"17. DELETE /workspaces/{id}":                    "Deletes the workspace with the provided id, owners only",
+		"18. POST /todos/{id}/share-links":               "Creates a read-only share link for the todo list with the provided id (optional body: expiresAt), returns its token once, owners only",
+		"19. GET /todos/{id}/share-links":                "Returns an array of the active share links of the todo list with the provided id, owners only",
+		"20. DELETE /todos/{id}/share-links/{linkId}":    "Revokes the share link with the provided link id, owners only",
+		"21. GET /shared/{token}":                        "Returns the todo list shared by the provided token without authentication, if the link is active",
 	}
 
-	writeResponse(w, http.StatusOK, apiInfo)
+	writeResponse(w, r, http.StatusOK, apiInfo)
 }
@@ -0,0 +1,63 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"net/http"
+)
+
+/*
+ * Function: NewRouter
+ * --------------------
+ * Instantiates a mux.Router writing problems for paths matching no route (see NotFound) and for methods not accepted
+ * by a route (see MethodNotAllowed).
+ *
+ * returns: a pointer to the mux.Router
+ */
+
+func NewRouter() *mux.Router {
+	router := mux.NewRouter()
+	router.NotFoundHandler = http.HandlerFunc(NotFound)
+	router.MethodNotAllowedHandler = http.HandlerFunc(MethodNotAllowed)
+	return router
+}
+
+/*
+ * Function: RegisterListRoutes
+ * --------------------
+ * Registers the routes of lists, including their exports, calendar feeds, collaborators and share links. Routes
+ * with a list id only match ids of the form domain.ListIdPattern; the route registered last answers all other ids
+ * with 400 (see MalformedListId).
+ *
+ * router: a pointer to the mux.Router the routes are registered on
+ * th: a pointer to the ToDoListHandlers serving lists
+ * sh: a pointer to the ShareLinkHandlers serving share links of lists
+ *
+ * returns: nothing
+ */
+
+func RegisterListRoutes(router *mux.Router, th *ToDoListHandlers, sh *ShareLinkHandlers) {
+	list := "/todos/{id:" + domain.ListIdPattern + "}"
+	router.HandleFunc("/calendar.ics", th.CalendarAll).Methods(http.MethodGet)
+	router.HandleFunc("/todos", th.GetAll).Methods(http.MethodGet)
+	router.HandleFunc("/todos", th.Save).Methods(http.MethodPost)
+	router.HandleFunc("/todos/export", th.ExportAll).Methods(http.MethodGet)
+	router.HandleFunc("/todos/import", th.Import).Methods(http.MethodPost)
+	router.HandleFunc(list, th.GetOne).Methods(http.MethodGet)
+	router.HandleFunc(list, th.Update).Methods(http.MethodPut)
+	router.HandleFunc(list, th.Delete).Methods(http.MethodDelete)
+	router.HandleFunc(list+"/export", th.Export).Methods(http.MethodGet)
+	router.HandleFunc(list+"/calendar.ics", th.Calendar).Methods(http.MethodGet)
+	router.HandleFunc(list+"/collaborators/{subject}", th.SetCollaborator).Methods(http.MethodPut)
+	router.HandleFunc(list+"/collaborators/{subject}", th.RemoveCollaborator).Methods(http.MethodDelete)
+	router.HandleFunc(list+"/share-links", sh.GetAll).Methods(http.MethodGet)
+	router.HandleFunc(list+"/share-links", sh.Create).Methods(http.MethodPost)
+	router.HandleFunc(list+"/share-links/{linkId}", sh.Revoke).Methods(http.MethodDelete)
+	router.MatcherFunc(MalformedListId("/todos/", "export", "import")).HandlerFunc(InvalidListId)
+}
@@ -0,0 +1,121 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/luschnat-ziegler/toDoListAPI/core/ports"
+	"net/http"
+	"time"
+)
+
+type ShareLinkHandlers struct {
+	Service ports.ShareLinkService
+}
+
+type shareLinkRequest struct {
+	ExpiresAt *time.Time `json:"expiresAt" xml:"expiresAt"`
+}
+
+/*
+ * Method: ShareLinkHandlers.Create
+ * --------------------
+ * To be called when a share link for the list with the id given in the path is requested. An expiry may be posted
+ * in the request body ({"expiresAt": "<RFC 3339 time>"}), an empty body creates a link that does not expire.
+ * On success, the new link including its token is written to the response body and code 201 to the header. The
+ * token is only returned once. If a pointer to an errs.AppError is returned by decodeBody or the service method,
+ * its message is written to the response body and its Code to the header, instead.
+ *
+ * w, r: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
+ *
+ * returns: nothing
+ */
+
+func (sh *ShareLinkHandlers) Create(w http.ResponseWriter, r *http.Request) {
+
+	var request shareLinkRequest
+	if r.ContentLength != 0 {
+		if appErr := decodeBody(r, &request); appErr != nil {
+			writeError(w, r, appErr)
+			return
+		}
+	}
+
+	shareLink, appErr := sh.Service.CreateShareLink(r.Context(), mux.Vars(r)["id"], request.ExpiresAt)
+	if appErr != nil {
+		writeError(w, r, appErr)
+		return
+	}
+	writeResponse(w, r, http.StatusCreated, shareLink)
+}
+
+/*
+ * Method: ShareLinkHandlers.GetAll
+ * --------------------
+ * To be called when the active share links of the list with the id given in the path are requested. Writes them
+ * (without tokens) to the response body and code 200 to the header. If a pointer to an errs.AppError is returned
+ * by the service method, its message is written to the response body and its Code to the header, instead.
+ *
+ * w, r: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
+ *
+ * returns: nothing
+ */
+
+func (sh *ShareLinkHandlers) GetAll(w http.ResponseWriter, r *http.Request) {
+	shareLinks, appErr := sh.Service.GetShareLinks(r.Context(), mux.Vars(r)["id"])
+	if appErr != nil {
+		writeError(w, r, appErr)
+		return
+	}
+	writeResponse(w, r, http.StatusOK, shareLinks)
+}
+
+/*
+ * Method: ShareLinkHandlers.Revoke
+ * --------------------
+ * To be called when the share link with the link id given in the path is to be revoked. Writes no response body
+ * and code 204 to the header. If a pointer to an errs.AppError is returned by the service method, its message is
+ * written to the response body and its Code to the header, instead.
+ *
+ * w, r: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
+ *
+ * returns: nothing
+ */
+
+func (sh *ShareLinkHandlers) Revoke(w http.ResponseWriter, r *http.Request) {
+
+	vars := mux.Vars(r)
+
+	appErr := sh.Service.RevokeShareLink(r.Context(), vars["id"], vars["linkId"])
+	if appErr != nil {
+		writeError(w, r, appErr)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+/*
+ * Method: ShareLinkHandlers.GetShared
+ * --------------------
+ * To be called when a list is requested by the token of a share link given in the path. Does not require
+ * authentication. Writes the list to the response body and code 200 to the header. If a pointer to an
+ * errs.AppError is returned by the service method, its message is written to the response body and its Code to
+ * the header, instead.
+ *
+ * w, r: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
+ *
+ * returns: nothing
+ */
+
+func (sh *ShareLinkHandlers) GetShared(w http.ResponseWriter, r *http.Request) {
+	list, appErr := sh.Service.GetSharedList(r.Context(), mux.Vars(r)["token"])
+	if appErr != nil {
+		writeError(w, r, appErr)
+		return
+	}
+	writeResponse(w, r, http.StatusOK, list)
+}
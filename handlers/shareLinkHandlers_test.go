@@ -0,0 +1,131 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"bytes"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/testUtils/mocks/ports"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+/*
+ * function: setupShareLinkHandlersTest
+ * --------------------
+ * Creates ShareLinkHandlers with a mocked service and a router serving its routes.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: the mocked service and the router
+ */
+
+func setupShareLinkHandlersTest(t *testing.T) (*ports.MockShareLinkService, *mux.Router) {
+	ctrl := gomock.NewController(t)
+	service := ports.NewMockShareLinkService(ctrl)
+	sh := ShareLinkHandlers{service}
+	shareLinkRouter := mux.NewRouter()
+	shareLinkRouter.HandleFunc("/todos/{id}/share-links", sh.Create).Methods(http.MethodPost)
+	shareLinkRouter.HandleFunc("/todos/{id}/share-links/{linkId}", sh.Revoke).Methods(http.MethodDelete)
+	shareLinkRouter.HandleFunc("/shared/{token}", sh.GetShared).Methods(http.MethodGet)
+	return service, shareLinkRouter
+}
+
+/*
+ * function: Test_ShareLinkHandlers_Create_should_pass_optional_expiry_to_service_method
+ * --------------------
+ * Tests if a link is created without expiry for an empty body, with the posted expiry otherwise, and the new link
+ * is written with status code 201.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ShareLinkHandlers_Create_should_pass_optional_expiry_to_service_method(t *testing.T) {
+	service, shareLinkRouter := setupShareLinkHandlersTest(t)
+	expiresAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	service.EXPECT().CreateShareLink(gomock.Any(), "test_id", (*time.Time)(nil)).
+		Return(&domain.ShareLink{Id: "link_id", Token: "secret"}, nil).
+		Times(1)
+	service.EXPECT().CreateShareLink(gomock.Any(), "test_id", &expiresAt).
+		Return(&domain.ShareLink{Id: "link_id", Token: "secret", ExpiresAt: &expiresAt}, nil).
+		Times(1)
+
+	for _, body := range []string{"", `{"expiresAt":"2030-01-02T03:04:05Z"}`} {
+		request, _ := http.NewRequest(http.MethodPost, "/todos/test_id/share-links", bytes.NewBufferString(body))
+		if body != "" {
+			request.Header.Set("Content-Type", "application/json")
+		}
+		recorder := httptest.NewRecorder()
+
+		shareLinkRouter.ServeHTTP(recorder, request)
+
+		if recorder.Code != http.StatusCreated {
+			t.Errorf("Expected code 201 for body %q, got %v instead", body, recorder.Code)
+		}
+		if !strings.Contains(recorder.Body.String(), `"token":"secret"`) {
+			t.Errorf("Expected token in response body, got %s", recorder.Body.String())
+		}
+	}
+}
+
+/*
+ * function: Test_ShareLinkHandlers_Revoke_should_write_no_content
+ * --------------------
+ * Tests if list and link id are passed to the service method and status code 204 is written.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ShareLinkHandlers_Revoke_should_write_no_content(t *testing.T) {
+	service, shareLinkRouter := setupShareLinkHandlersTest(t)
+	service.EXPECT().RevokeShareLink(gomock.Any(), "test_id", "link_id").Return(nil).Times(1)
+
+	request, _ := http.NewRequest(http.MethodDelete, "/todos/test_id/share-links/link_id", nil)
+	recorder := httptest.NewRecorder()
+
+	shareLinkRouter.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusNoContent {
+		t.Errorf("Expected code 204, got %v instead", recorder.Code)
+	}
+}
+
+/*
+ * function: Test_ShareLinkHandlers_GetShared_should_write_error_of_service_method
+ * --------------------
+ * Tests if the code of an error returned by the service method for an unknown token is written.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ShareLinkHandlers_GetShared_should_write_error_of_service_method(t *testing.T) {
+	service, shareLinkRouter := setupShareLinkHandlersTest(t)
+	service.EXPECT().GetSharedList(gomock.Any(), "unknown").
+		Return(nil, errs.NewNotFoundError("No share link matching token")).
+		Times(1)
+
+	request, _ := http.NewRequest(http.MethodGet, "/shared/unknown", nil)
+	recorder := httptest.NewRecorder()
+
+	shareLinkRouter.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusNotFound {
+		t.Errorf("Expected code 404, got %v instead", recorder.Code)
+	}
+}
@@ -7,18 +7,28 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/gorilla/mux"
 	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
 	"github.com/luschnat-ziegler/toDoListAPI/core/ports"
 	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/logger"
+	"github.com/luschnat-ziegler/toDoListAPI/tracing"
 	"net/http"
+	"strings"
 )
 
+const problemMediaType = "application/problem+json"
+
 type ToDoListHandlers struct {
 	Service ports.ToDoListService
 }
 
+type problemError interface {
+	AsProblem() *errs.Problem
+}
+
 /*
  * Method: ToDoListHandlers.GetAll
  * --------------------
@@ -26,25 +36,26 @@ type ToDoListHandlers struct {
  * If a pointer to an errs.AppError is returned by the service method, its message is written to the response body
  * and its Code to the header, instead.
  *
- * w, _: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
+ * w, r: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
  *
  * returns: nothing
  */
 
-func (ah *ToDoListHandlers) GetAll(w http.ResponseWriter, _ *http.Request) {
-	lists, err := ah.Service.GetAllLists()
+func (ah *ToDoListHandlers) GetAll(w http.ResponseWriter, r *http.Request) {
+	lists, err := ah.Service.GetAllLists(r.Context())
 	if err != nil {
-		writeResponse(w, err.Code, err.AsMessage())
+		writeError(w, r, err)
 		return
 	}
-	writeResponse(w, http.StatusOK, lists)
+	writeResponse(w, r, http.StatusOK, lists)
 }
 
 /*
  * Method: ToDoListHandlers.Save
  * --------------------
- * To be called when a posted list is to be saved. Rejects invalid JSON bodies and lists failing validation and writes
- * the respective information as JSON to the response body as well as the error code to the header.
+ * To be called when a posted list is to be saved. Rejects bodies that cannot be decoded (see decodeBody) and lists
+ * failing validation and writes the respective information to the response body as well as the error code to the
+ * header.
  * If a pointer to an errs.AppError is returned by the service method, its message is
  * written to the response body and its Code to the header.
  * On success, the newly created resource is written to the response body as JSON and code 201 to the header.
@@ -58,25 +69,26 @@ func (ah *ToDoListHandlers) Save(w http.ResponseWriter, r *http.Request) {
 
 	var newList domain.ToDoList
 
-	err := json.NewDecoder(r.Body).Decode(&newList)
-	if err != nil {
-		writeResponse(w, http.StatusBadRequest, errs.NewBadRequestError("Body parsing error").AsMessage())
+	if appErr := decodeBody(r, &newList); appErr != nil {
+		writeError(w, r, appErr)
 		return
 	}
 
+	_, span := tracing.Start(r.Context(), "ToDoList.Validate")
 	validationError := newList.Validate()
+	span.End()
 	if validationError != nil {
-		writeResponse(w, validationError.Code, validationError.AsMessage())
+		writeError(w, r, validationError)
 		return
 	}
 
-	getListResponse, appErr := ah.Service.SaveList(newList)
+	getListResponse, appErr := ah.Service.SaveList(r.Context(), newList)
 	if appErr != nil {
-		writeResponse(w, appErr.Code, appErr.AsMessage())
+		writeError(w, r, appErr)
 		return
 	}
 
-	writeResponse(w, http.StatusCreated, getListResponse)
+	writeResponse(w, r, http.StatusCreated, getListResponse)
 }
 
 /*
@@ -95,13 +107,13 @@ func (ah *ToDoListHandlers) GetOne(w http.ResponseWriter, r *http.Request) {
 
 	id := mux.Vars(r)["id"]
 
-	getListResponse, appErr := ah.Service.GetOneListById(id)
+	getListResponse, appErr := ah.Service.GetOneListById(r.Context(), id)
 	if appErr != nil {
-		writeResponse(w, appErr.Code, appErr.AsMessage())
+		writeError(w, r, appErr)
 		return
 	}
 
-	writeResponse(w, http.StatusOK, getListResponse)
+	writeResponse(w, r, http.StatusOK, getListResponse)
 }
 
 /*
@@ -121,25 +133,26 @@ func (ah *ToDoListHandlers) Update(w http.ResponseWriter, r *http.Request) {
 	id := mux.Vars(r)["id"]
 
 	var newList domain.ToDoList
-	err := json.NewDecoder(r.Body).Decode(&newList)
-	if err != nil {
-		writeResponse(w, http.StatusBadRequest, errs.NewBadRequestError("Body parsing error").AsMessage())
+	if appErr := decodeBody(r, &newList); appErr != nil {
+		writeError(w, r, appErr)
 		return
 	}
 
+	_, span := tracing.Start(r.Context(), "ToDoList.Validate")
 	validationError := newList.Validate()
+	span.End()
 	if validationError != nil {
-		writeResponse(w, validationError.Code, validationError.AsMessage())
+		writeError(w, r, validationError)
 		return
 	}
 
-	updatedList, appErr := ah.Service.UpdateOneListById(id, newList)
+	updatedList, appErr := ah.Service.UpdateOneListById(r.Context(), id, newList)
 	if appErr != nil {
-		writeResponse(w, appErr.Code, appErr.AsMessage())
+		writeError(w, r, appErr)
 		return
 	}
 
-	writeResponse(w, http.StatusOK, updatedList)
+	writeResponse(w, r, http.StatusOK, updatedList)
 }
 
 /*
@@ -158,9 +171,9 @@ func (ah *ToDoListHandlers) Delete(w http.ResponseWriter, r *http.Request) {
 
 	id := mux.Vars(r)["id"]
 
-	appErr := ah.Service.DeleteListById(id)
+	appErr := ah.Service.DeleteListById(r.Context(), id)
 	if appErr != nil {
-		writeResponse(w, appErr.Code, appErr.AsMessage())
+		writeError(w, r, appErr)
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
@@ -169,19 +182,78 @@ func (ah *ToDoListHandlers) Delete(w http.ResponseWriter, r *http.Request) {
 /*
  * Function: writeResponse
  * --------------------
- * Utility function for writing http responses with status code and JSON body.
+ * Utility function for writing http responses with status code and body. The body is encoded in the format
+ * negotiated from the Accept header of the request (JSON, YAML or MessagePack, see negotiateCodec). If none of the
+ * accepted formats is supported, the response is replaced by a problem with code 406 (see writeError). The body is
+ * encoded completely before the header is written, so that an encoding error is answered with a problem with code
+ * 500 instead of a partial response with the intended status code.
  *
  * w: an http.ResponseWriter to be used for writing the response
+ * r: a pointer to the http.Request to be answered
  * code: an integer representing a status code
  * data: The data to be written, generic type
  *
  * returns: nothing
  */
 
-func writeResponse(w http.ResponseWriter, code int, data interface{}) {
-	w.Header().Add("Content-Type", "application/json")
+func writeResponse(w http.ResponseWriter, r *http.Request, code int, data interface{}) {
+	c, ok := negotiateCodec(r)
+	if !ok {
+		writeError(w, r, errs.Localized(errs.NewNotAcceptableError, errs.MessageNotAcceptable, "types", strings.Join(mediaTypes(), ", ")))
+		return
+	}
+
+	body, err := c.marshal(data)
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Error encoding response: " + err.Error())
+		writeError(w, r, errs.NewInternalError("Encoding error"))
+		return
+	}
+
+	w.Header().Add("Content-Type", c.mediaType)
 	w.WriteHeader(code)
-	if err := json.NewEncoder(w).Encode(data); err != nil {
-		panic(err)
+	if _, err := w.Write(body); err != nil {
+		logger.FromContext(r.Context()).Error("Error writing response: " + err.Error())
 	}
-}
\ No newline at end of file
+}
+
+/*
+ * Function: writeError
+ * --------------------
+ * Utility function for writing error responses as RFC 7807 problem details (content type
+ * application/problem+json) regardless of the Accept header. The status code of the response is taken from the
+ * problem, its instance is set to the path of the request. Messages are translated into the locale negotiated from
+ * the Accept-Language header (see negotiateLocale), which is announced in the Content-Language header.
+ *
+ * w: an http.ResponseWriter to be used for writing the response
+ * r: a pointer to the http.Request to be answered
+ * err: the error to be written, e.g. a pointer to an errs.AppError or errs.ValidationError
+ *
+ * returns: nothing
+ */
+
+func writeError(w http.ResponseWriter, r *http.Request, err problemError) {
+	problem := err.AsProblem()
+	ctx := context.Background()
+	if r != nil {
+		problem.Instance = r.URL.Path
+		ctx = r.Context()
+	}
+	locale := negotiateLocale(r)
+	problem.Localize(locale)
+
+	body, marshalErr := json.Marshal(problem)
+	if marshalErr != nil {
+		logger.FromContext(ctx).Error("Error encoding problem: " + marshalErr.Error())
+		problem = errs.NewProblem(http.StatusInternalServerError, errs.CodeInternal, "Encoding error")
+		body, _ = json.Marshal(problem)
+	}
+
+	w.Header().Set("Content-Type", problemMediaType)
+	w.Header().Set("Content-Language", locale)
+	w.Header().Add("Vary", "Accept-Language")
+	w.WriteHeader(problem.Status)
+	if _, writeErr := w.Write(append(body, '\n')); writeErr != nil {
+		logger.FromContext(ctx).Error("Error writing problem: " + writeErr.Error())
+	}
+}
@@ -8,6 +8,7 @@ package handlers
 
 import (
 	"bytes"
+	"fmt"
 	"github.com/golang/mock/gomock"
 	"github.com/gorilla/mux"
 	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
@@ -63,7 +64,7 @@ func Test_ToDoListHandlers_GetAll_should_write_lists_returned_by_service_method_
 	dummyLists := []domain.ToDoList{
 		dummies.DummyListValid,
 	}
-	mockDefaultToDoListService.EXPECT().GetAllLists().Return(&dummyLists, nil).Times(1)
+	mockDefaultToDoListService.EXPECT().GetAllLists(gomock.Any()).Return(&dummyLists, nil).Times(1)
 
 	request, _ := http.NewRequest(http.MethodGet, "/todos", nil)
 	recorder := httptest.NewRecorder()
@@ -97,7 +98,7 @@ func Test_ToDoListHandlers_GetAll_should_write_error_returned_by_service_method_
 	defer teardown()
 
 	router.HandleFunc("/todos", th.GetAll)
-	mockDefaultToDoListService.EXPECT().GetAllLists().Return(nil, dummies.DummyInternalError).Times(1)
+	mockDefaultToDoListService.EXPECT().GetAllLists(gomock.Any()).Return(nil, dummies.DummyInternalError).Times(1)
 
 	request, _ := http.NewRequest(http.MethodGet, "/todos", nil)
 	recorder := httptest.NewRecorder()
@@ -111,7 +112,7 @@ func Test_ToDoListHandlers_GetAll_should_write_error_returned_by_service_method_
 	resBody := recorder.Body.String()
 	resBody = resBody[:len(resBody)-1]
 
-	if resBody != dummies.DummyInternalErrorAsJSON {
+	if resBody != fmt.Sprintf(dummies.DummyInternalErrorAsJSON, "/todos") {
 		t.Error("Response body does not match")
 	}
 }
@@ -132,7 +133,7 @@ func Test_ToDoListHandlers_Save_should_write_list_returned_by_service_method_to_
 	defer teardown()
 
 	router.HandleFunc("/todos", th.Save)
-	mockDefaultToDoListService.EXPECT().SaveList(dummies.DummyListValid).Return(&dummies.DummyListValidWithIds, nil).Times(1)
+	mockDefaultToDoListService.EXPECT().SaveList(gomock.Any(), dummies.DummyListValid).Return(&dummies.DummyListValidWithIds, nil).Times(1)
 
 	request, _ := http.NewRequest(http.MethodPost, "/todos", bytes.NewBuffer([]byte(dummies.DummyValidSaveListRequestAsJSON)))
 	recorder := httptest.NewRecorder()
@@ -167,7 +168,7 @@ func Test_ToDoListHandlers_Save_should_write_error_returned_by_service_method_to
 	defer teardown()
 
 	router.HandleFunc("/todos", th.Save)
-	mockDefaultToDoListService.EXPECT().SaveList(dummies.DummyListValid).Return(nil, dummies.DummyInternalError).Times(1)
+	mockDefaultToDoListService.EXPECT().SaveList(gomock.Any(), dummies.DummyListValid).Return(nil, dummies.DummyInternalError).Times(1)
 
 	request, _ := http.NewRequest(http.MethodPost, "/todos", bytes.NewBuffer([]byte(dummies.DummyValidSaveListRequestAsJSON)))
 	recorder := httptest.NewRecorder()
@@ -181,7 +182,7 @@ func Test_ToDoListHandlers_Save_should_write_error_returned_by_service_method_to
 	resBody := recorder.Body.String()
 	resBody = resBody[:len(resBody)-1]
 
-	if resBody != dummies.DummyInternalErrorAsJSON {
+	if resBody != fmt.Sprintf(dummies.DummyInternalErrorAsJSON, "/todos") {
 		t.Error("Response body does not match")
 	}
 }
@@ -215,7 +216,7 @@ func Test_ToDoListHandlers_Save_should_write_error_400_to_json_body_if_JSON_inva
 	resBody := recorder.Body.String()
 	resBody = resBody[:len(resBody)-1]
 
-	if resBody != dummies.DummyBadRequestErrorAsJSON {
+	if resBody != fmt.Sprintf(dummies.DummyBadRequestErrorAsJSON, "/todos") {
 		t.Error("Response body does not match")
 	}
 }
@@ -249,7 +250,7 @@ func Test_ToDoListHandlers_Save_should_write_validation_error_to_json_body_if_va
 	resBody := recorder.Body.String()
 	resBody = resBody[:len(resBody)-1]
 
-	if resBody != dummies.DummyValidationErrorAsJSON {
+	if resBody != fmt.Sprintf(dummies.DummyValidationErrorAsJSON, "/todos") {
 		t.Error("Response body does not match")
 	}
 }
@@ -270,7 +271,7 @@ func Test_ToDoListHandlers_GetOne_should_write_list_returned_by_service_method_t
 	defer teardown()
 
 	router.HandleFunc("/todos/{id}", th.GetOne)
-	mockDefaultToDoListService.EXPECT().GetOneListById("test_id").Return(&dummies.DummyListValidWithIds, nil).Times(1)
+	mockDefaultToDoListService.EXPECT().GetOneListById(gomock.Any(), "test_id").Return(&dummies.DummyListValidWithIds, nil).Times(1)
 
 	request, _ := http.NewRequest(http.MethodGet, "/todos/test_id", nil)
 	recorder := httptest.NewRecorder()
@@ -305,7 +306,7 @@ func Test_ToDoListHandlers_GetOne_should_write_error_returned_by_service_method_
 	defer teardown()
 
 	router.HandleFunc("/todos/{id}", th.GetOne)
-	mockDefaultToDoListService.EXPECT().GetOneListById("test_id").Return(nil, dummies.DummyInternalError).Times(1)
+	mockDefaultToDoListService.EXPECT().GetOneListById(gomock.Any(), "test_id").Return(nil, dummies.DummyInternalError).Times(1)
 
 	request, _ := http.NewRequest(http.MethodGet, "/todos/test_id", nil)
 	recorder := httptest.NewRecorder()
@@ -319,7 +320,7 @@ func Test_ToDoListHandlers_GetOne_should_write_error_returned_by_service_method_
 	resBody := recorder.Body.String()
 	resBody = resBody[:len(resBody)-1]
 
-	if resBody != dummies.DummyInternalErrorAsJSON {
+	if resBody != fmt.Sprintf(dummies.DummyInternalErrorAsJSON, "/todos/test_id") {
 		t.Error("Response body does not match")
 	}
 }
@@ -340,7 +341,7 @@ func Test_ToDoListHandlers_Update_should_write_list_returned_by_service_method_t
 	defer teardown()
 
 	router.HandleFunc("/todos/{id}", th.Update)
-	mockDefaultToDoListService.EXPECT().UpdateOneListById("test_id", dummies.DummyListValid).
+	mockDefaultToDoListService.EXPECT().UpdateOneListById(gomock.Any(), "test_id", dummies.DummyListValid).
 		Return(&dummies.DummyListValidWithIds, nil).
 		Times(1)
 
@@ -380,7 +381,7 @@ func Test_ToDoListHandlers_Update_should_write_error_returned_by_service_method_
 	defer teardown()
 
 	router.HandleFunc("/todos/{id}", th.Update)
-	mockDefaultToDoListService.EXPECT().UpdateOneListById("test_id", dummies.DummyListValid).
+	mockDefaultToDoListService.EXPECT().UpdateOneListById(gomock.Any(), "test_id", dummies.DummyListValid).
 		Return(nil, dummies.DummyInternalError).
 		Times(1)
 
@@ -399,7 +400,7 @@ func Test_ToDoListHandlers_Update_should_write_error_returned_by_service_method_
 	resBody := recorder.Body.String()
 	resBody = resBody[:len(resBody)-1]
 
-	if resBody != dummies.DummyInternalErrorAsJSON {
+	if resBody != fmt.Sprintf(dummies.DummyInternalErrorAsJSON, "/todos/test_id") {
 		t.Error("Response body does not match")
 	}
 }
@@ -437,7 +438,7 @@ func Test_ToDoListHandlers_Update_should_write_error_400_to_json_body_if_JSON_in
 	resBody := recorder.Body.String()
 	resBody = resBody[:len(resBody)-1]
 
-	if resBody != dummies.DummyBadRequestErrorAsJSON {
+	if resBody != fmt.Sprintf(dummies.DummyBadRequestErrorAsJSON, "/todos/test_id") {
 		t.Error("Response body does not match")
 	}
 }
@@ -474,7 +475,7 @@ func Test_ToDoListHandlers_Update_should_write_validation_error_to_json_body_if_
 	resBody := recorder.Body.String()
 	resBody = resBody[:len(resBody)-1]
 
-	if resBody != dummies.DummyValidationErrorAsJSON {
+	if resBody != fmt.Sprintf(dummies.DummyValidationErrorAsJSON, "/todos/test_id") {
 		t.Error("Response body does not match")
 	}
 }
@@ -494,7 +495,7 @@ func Test_ToDoListHandlers_Delete_should_write_204_if_service_method_returns_no_
 	defer teardown()
 
 	router.HandleFunc("/todos/{id}", th.Delete)
-	mockDefaultToDoListService.EXPECT().DeleteList("test_id").Return(nil).Times(1)
+	mockDefaultToDoListService.EXPECT().DeleteList(gomock.Any(), "test_id").Return(nil).Times(1)
 
 	request, _ := http.NewRequest(http.MethodDelete, "/todos/test_id", nil)
 	recorder := httptest.NewRecorder()
@@ -522,7 +523,7 @@ func Test_ToDoListHandlers_Delete_should_write_error_returned_by_service(t *test
 	defer teardown()
 
 	router.HandleFunc("/todos/{id}", th.Delete)
-	mockDefaultToDoListService.EXPECT().DeleteList("test_id").
+	mockDefaultToDoListService.EXPECT().DeleteList(gomock.Any(), "test_id").
 		Return(dummies.DummyInternalError).
 		Times(1)
 
@@ -538,7 +539,109 @@ func Test_ToDoListHandlers_Delete_should_write_error_returned_by_service(t *test
 	resBody := recorder.Body.String()
 	resBody = resBody[:len(resBody)-1]
 
-	if resBody != dummies.DummyInternalErrorAsJSON {
+	if resBody != fmt.Sprintf(dummies.DummyInternalErrorAsJSON, "/todos/test_id") {
 		t.Error("Response body does not match")
 	}
 }
+
+/*
+ * function: Test_ToDoListHandlers_GetOne_should_write_error_400_for_malformed_id_without_calling_service
+ * --------------------
+ * Tests if the routes registered by RegisterListRoutes answer a GET request with a malformed list id with the
+ * problem for invalid ids and status code 400, without calling the service method.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_GetOne_should_write_error_400_for_malformed_id_without_calling_service(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	RegisterListRoutes(router, &th, &ShareLinkHandlers{})
+
+	request, _ := http.NewRequest(http.MethodGet, "/todos/test_id", nil)
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("Expected code 400, got %v instead", recorder.Code)
+	}
+
+	resBody := recorder.Body.String()
+	resBody = resBody[:len(resBody)-1]
+
+	if resBody != fmt.Sprintf(dummies.DummyInvalidIdErrorAsJSON, "/todos/test_id") {
+		t.Errorf("Response body does not match, got %v", resBody)
+	}
+}
+
+/*
+ * function: Test_ToDoListHandlers_Update_should_write_error_400_for_malformed_id_without_calling_service
+ * --------------------
+ * Tests if the routes registered by RegisterListRoutes answer a PUT request with a malformed list id with the
+ * problem for invalid ids and status code 400, without calling the service method.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_Update_should_write_error_400_for_malformed_id_without_calling_service(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	RegisterListRoutes(router, &th, &ShareLinkHandlers{})
+
+	request, _ := http.NewRequest(http.MethodPut, "/todos/test_id", bytes.NewBuffer([]byte(dummies.DummyValidSaveListRequestAsJSON)))
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("Expected code 400, got %v instead", recorder.Code)
+	}
+
+	resBody := recorder.Body.String()
+	resBody = resBody[:len(resBody)-1]
+
+	if resBody != fmt.Sprintf(dummies.DummyInvalidIdErrorAsJSON, "/todos/test_id") {
+		t.Errorf("Response body does not match, got %v", resBody)
+	}
+}
+
+/*
+ * function: Test_ToDoListHandlers_Delete_should_write_error_400_for_malformed_id_without_calling_service
+ * --------------------
+ * Tests if the routes registered by RegisterListRoutes answer a DELETE request with a malformed list id with the
+ * problem for invalid ids and status code 400, without calling the service method.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListHandlers_Delete_should_write_error_400_for_malformed_id_without_calling_service(t *testing.T) {
+	teardown := setupToDoListHandlersTest(t)
+	defer teardown()
+
+	RegisterListRoutes(router, &th, &ShareLinkHandlers{})
+
+	request, _ := http.NewRequest(http.MethodDelete, "/todos/test_id", nil)
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("Expected code 400, got %v instead", recorder.Code)
+	}
+
+	resBody := recorder.Body.String()
+	resBody = resBody[:len(resBody)-1]
+
+	if resBody != fmt.Sprintf(dummies.DummyInvalidIdErrorAsJSON, "/todos/test_id") {
+		t.Errorf("Response body does not match, got %v", resBody)
+	}
+}
@@ -0,0 +1,157 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/core/ports"
+	"net/http"
+)
+
+type WorkspaceHandlers struct {
+	Service ports.WorkspaceService
+}
+
+/*
+ * Method: WorkspaceHandlers.GetAll
+ * --------------------
+ * To be called when all workspaces of the principal are requested. Writes them to the response body and code 200
+ * to the header. If a pointer to an errs.AppError is returned by the service method, its message is written to the
+ * response body and its Code to the header, instead.
+ *
+ * w, r: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
+ *
+ * returns: nothing
+ */
+
+func (wh *WorkspaceHandlers) GetAll(w http.ResponseWriter, r *http.Request) {
+	workspaces, appErr := wh.Service.GetAllWorkspaces(r.Context())
+	if appErr != nil {
+		writeError(w, r, appErr)
+		return
+	}
+	writeResponse(w, r, http.StatusOK, workspaces)
+}
+
+/*
+ * Method: WorkspaceHandlers.Save
+ * --------------------
+ * To be called when a posted workspace is to be saved. Rejects bodies that cannot be decoded (see decodeBody) and
+ * workspaces failing validation. On success, the new workspace is written to the response body and code 201 to
+ * the header. If a pointer to an errs.AppError is returned by the service method, its message is written to the
+ * response body and its Code to the header, instead.
+ *
+ * w, r: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
+ *
+ * returns: nothing
+ */
+
+func (wh *WorkspaceHandlers) Save(w http.ResponseWriter, r *http.Request) {
+
+	var newWorkspace domain.Workspace
+	if appErr := decodeBody(r, &newWorkspace); appErr != nil {
+		writeError(w, r, appErr)
+		return
+	}
+
+	if validationError := newWorkspace.Validate(); validationError != nil {
+		writeError(w, r, validationError)
+		return
+	}
+
+	workspace, appErr := wh.Service.SaveWorkspace(r.Context(), newWorkspace)
+	if appErr != nil {
+		writeError(w, r, appErr)
+		return
+	}
+
+	writeResponse(w, r, http.StatusCreated, workspace)
+}
+
+/*
+ * Method: WorkspaceHandlers.GetOne
+ * --------------------
+ * To be called when one specific workspace is requested. Writes it to the response body and code 200 to the
+ * header. If a pointer to an errs.AppError is returned by the service method, its message is written to the
+ * response body and its Code to the header, instead.
+ *
+ * w, r: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
+ *
+ * returns: nothing
+ */
+
+func (wh *WorkspaceHandlers) GetOne(w http.ResponseWriter, r *http.Request) {
+
+	workspace, appErr := wh.Service.GetWorkspaceById(r.Context(), mux.Vars(r)["id"])
+	if appErr != nil {
+		writeError(w, r, appErr)
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, workspace)
+}
+
+/*
+ * Method: WorkspaceHandlers.Update
+ * --------------------
+ * To be called when name and members of one specific workspace are to be overwritten. The id in the path takes
+ * precedence over an id in the body. Writes the updated workspace to the response body and code 200 to the header.
+ * If a pointer to an errs.AppError is returned by the service method, its message is written to the response body
+ * and its Code to the header, instead.
+ *
+ * w, r: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
+ *
+ * returns: nothing
+ */
+
+func (wh *WorkspaceHandlers) Update(w http.ResponseWriter, r *http.Request) {
+
+	id := mux.Vars(r)["id"]
+
+	var newWorkspace domain.Workspace
+	if appErr := decodeBody(r, &newWorkspace); appErr != nil {
+		writeError(w, r, appErr)
+		return
+	}
+	newWorkspace.Id = id
+
+	if validationError := newWorkspace.Validate(); validationError != nil {
+		writeError(w, r, validationError)
+		return
+	}
+
+	workspace, appErr := wh.Service.UpdateWorkspaceById(r.Context(), id, newWorkspace)
+	if appErr != nil {
+		writeError(w, r, appErr)
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, workspace)
+}
+
+/*
+ * Method: WorkspaceHandlers.Delete
+ * --------------------
+ * To be called when one specific workspace is requested to be deleted. Writes no response body and code 204 to
+ * the header. If a pointer to an errs.AppError is returned by the service method, its message is written to the
+ * response body and its Code to the header, instead.
+ *
+ * w, r: an http.ResponseWriter and a pointer to an http.Request needed to meet the handler function signature.
+ *
+ * returns: nothing
+ */
+
+func (wh *WorkspaceHandlers) Delete(w http.ResponseWriter, r *http.Request) {
+
+	appErr := wh.Service.DeleteWorkspaceById(r.Context(), mux.Vars(r)["id"])
+	if appErr != nil {
+		writeError(w, r, appErr)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
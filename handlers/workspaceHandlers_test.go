@@ -0,0 +1,193 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/testUtils/mocks/ports"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+/*
+ * function: setupWorkspaceHandlersTest
+ * --------------------
+ * Creates WorkspaceHandlers with a mocked service and a router serving its routes.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: the mocked service and the router
+ */
+
+func setupWorkspaceHandlersTest(t *testing.T) (*ports.MockWorkspaceService, *mux.Router) {
+	ctrl := gomock.NewController(t)
+	service := ports.NewMockWorkspaceService(ctrl)
+	wh := WorkspaceHandlers{service}
+	workspaceRouter := mux.NewRouter()
+	workspaceRouter.HandleFunc("/workspaces", wh.GetAll).Methods(http.MethodGet)
+	workspaceRouter.HandleFunc("/workspaces", wh.Save).Methods(http.MethodPost)
+	workspaceRouter.HandleFunc("/workspaces/{id}", wh.GetOne).Methods(http.MethodGet)
+	workspaceRouter.HandleFunc("/workspaces/{id}", wh.Update).Methods(http.MethodPut)
+	workspaceRouter.HandleFunc("/workspaces/{id}", wh.Delete).Methods(http.MethodDelete)
+	return service, workspaceRouter
+}
+
+/*
+ * function: serveWorkspaceRequest
+ * --------------------
+ * Serves a request with an optional JSON body.
+ *
+ * handler: the http.Handler serving the request
+ * method: the http method of the request
+ * path: the path of the request
+ * body: the JSON body of the request, or the empty string
+ *
+ * Returns: a pointer to the httptest.ResponseRecorder
+ */
+
+func serveWorkspaceRequest(handler http.Handler, method string, path string, body string) *httptest.ResponseRecorder {
+	request, _ := http.NewRequest(method, path, bytes.NewBufferString(body))
+	if body != "" {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+/*
+ * function: Test_WorkspaceHandlers_Save_should_write_201_or_error_of_service_method
+ * --------------------
+ * Tests if a valid workspace is passed to the service method and written with status code 201, a taken id is
+ * answered with the code 409 of the service method and an invalid id with code 400 without calling the service.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_WorkspaceHandlers_Save_should_write_201_or_error_of_service_method(t *testing.T) {
+	service, workspaceRouter := setupWorkspaceHandlersTest(t)
+	teamA := domain.Workspace{Id: "teama", Name: "Team A", Members: []string{"bob"}}
+	service.EXPECT().SaveWorkspace(gomock.Any(), teamA).
+		Return(&domain.Workspace{Id: "teama", OwnerId: "alice", Name: "Team A", Members: []string{"bob"}}, nil).
+		Times(1)
+	service.EXPECT().SaveWorkspace(gomock.Any(), domain.Workspace{Id: "teamb", Name: "Team B", Members: []string{}}).
+		Return(nil, errs.NewConflictError("Workspace teamb already exists")).
+		Times(1)
+
+	recorder := serveWorkspaceRequest(workspaceRouter, http.MethodPost, "/workspaces", `{"id":"teama","name":"Team A","members":["bob"]}`)
+	var workspace domain.Workspace
+	if err := json.Unmarshal(recorder.Body.Bytes(), &workspace); err != nil || recorder.Code != http.StatusCreated {
+		t.Fatalf("Expected code 201 and workspace, got %v and %s instead", recorder.Code, recorder.Body.String())
+	}
+	if workspace.OwnerId != "alice" {
+		t.Errorf("Expected workspace of service method, got %+v instead", workspace)
+	}
+
+	recorder = serveWorkspaceRequest(workspaceRouter, http.MethodPost, "/workspaces", `{"id":"teamb","name":"Team B","members":[]}`)
+	if recorder.Code != http.StatusConflict {
+		t.Errorf("Expected code 409, got %v instead", recorder.Code)
+	}
+
+	recorder = serveWorkspaceRequest(workspaceRouter, http.MethodPost, "/workspaces", `{"id":"Team.C","name":"Team C","members":[]}`)
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("Expected code 400, got %v instead", recorder.Code)
+	}
+}
+
+/*
+ * function: Test_WorkspaceHandlers_GetOne_should_write_workspace_or_404
+ * --------------------
+ * Tests if the id in the path is passed to the service method and the workspace is written with status code 200,
+ * or the code 404 of the service method for workspaces the principal does not belong to.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_WorkspaceHandlers_GetOne_should_write_workspace_or_404(t *testing.T) {
+	service, workspaceRouter := setupWorkspaceHandlersTest(t)
+	service.EXPECT().GetWorkspaceById(gomock.Any(), "teama").Return(&domain.Workspace{Id: "teama", Name: "Team A"}, nil).Times(1)
+	service.EXPECT().GetWorkspaceById(gomock.Any(), "teamb").
+		Return(nil, errs.Localized(errs.NewNotFoundError, errs.MessageWorkspaceNotFound, "id", "teamb")).
+		Times(1)
+
+	if recorder := serveWorkspaceRequest(workspaceRouter, http.MethodGet, "/workspaces/teama", ""); recorder.Code != http.StatusOK {
+		t.Errorf("Expected code 200, got %v instead", recorder.Code)
+	}
+	if recorder := serveWorkspaceRequest(workspaceRouter, http.MethodGet, "/workspaces/teamb", ""); recorder.Code != http.StatusNotFound {
+		t.Errorf("Expected code 404, got %v instead", recorder.Code)
+	}
+}
+
+/*
+ * function: Test_WorkspaceHandlers_Update_should_use_id_of_path_and_write_403_for_members
+ * --------------------
+ * Tests if the id in the path takes precedence over the id in the body and the code 403 returned by the service
+ * method for members other than the owner is written.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_WorkspaceHandlers_Update_should_use_id_of_path_and_write_403_for_members(t *testing.T) {
+	service, workspaceRouter := setupWorkspaceHandlersTest(t)
+	service.EXPECT().UpdateWorkspaceById(gomock.Any(), "teama", domain.Workspace{Id: "teama", Name: "Team A", Members: []string{}}).
+		Return(&domain.Workspace{Id: "teama", Name: "Team A"}, nil).
+		Times(1)
+	service.EXPECT().UpdateWorkspaceById(gomock.Any(), "teamb", gomock.Any()).
+		Return(nil, errs.NewForbiddenError("Only the owner may change the workspace")).
+		Times(1)
+
+	recorder := serveWorkspaceRequest(workspaceRouter, http.MethodPut, "/workspaces/teama", `{"id":"other","name":"Team A","members":[]}`)
+	if recorder.Code != http.StatusOK {
+		t.Errorf("Expected code 200, got %v instead", recorder.Code)
+	}
+
+	recorder = serveWorkspaceRequest(workspaceRouter, http.MethodPut, "/workspaces/teamb", `{"name":"Team B","members":[]}`)
+	if recorder.Code != http.StatusForbidden {
+		t.Errorf("Expected code 403, got %v instead", recorder.Code)
+	}
+}
+
+/*
+ * function: Test_WorkspaceHandlers_Delete_should_write_no_content_or_error_of_service_method
+ * --------------------
+ * Tests if the id in the path is passed to the service method and status code 204 is written on success, the code
+ * of the error returned by the service method otherwise.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_WorkspaceHandlers_Delete_should_write_no_content_or_error_of_service_method(t *testing.T) {
+	service, workspaceRouter := setupWorkspaceHandlersTest(t)
+	service.EXPECT().DeleteWorkspaceById(gomock.Any(), "teama").Return(nil).Times(1)
+	service.EXPECT().DeleteWorkspaceById(gomock.Any(), "teamb").
+		Return(errs.NewForbiddenError("Only the owner may change the workspace")).
+		Times(1)
+
+	recorder := serveWorkspaceRequest(workspaceRouter, http.MethodDelete, "/workspaces/teama", "")
+	if recorder.Code != http.StatusNoContent || recorder.Body.Len() != 0 {
+		t.Errorf("Expected code 204 and no body, got %v and %q instead", recorder.Code, recorder.Body.String())
+	}
+
+	recorder = serveWorkspaceRequest(workspaceRouter, http.MethodDelete, "/workspaces/teamb", "")
+	if recorder.Code != http.StatusForbidden {
+		t.Errorf("Expected code 403, got %v instead", recorder.Code)
+	}
+}
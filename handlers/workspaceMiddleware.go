@@ -0,0 +1,68 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/luschnat-ziegler/toDoListAPI/auth"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/core/ports"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/logger"
+	"github.com/luschnat-ziegler/toDoListAPI/tenancy"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+/*
+ * Function: ResolveWorkspace
+ * --------------------
+ * Instantiates a middleware resolving the workspace a request is scoped to and storing it in the request context
+ * (see tenancy.WorkspaceFromContext). A workspace claimed by the token of the principal takes precedence; requests
+ * naming a different workspace in the X-Workspace-ID header or claiming an invalid id (see
+ * domain.IsValidWorkspaceId) are rejected with code 403. Otherwise the workspace
+ * named in the header is used if the principal belongs to it (see ports.WorkspaceService.GetWorkspaceById). Without
+ * either, the default workspace is used.
+ *
+ * service: an implementation of ports.WorkspaceService used to look up workspaces named in the header
+ *
+ * returns: a mux.MiddlewareFunc
+ */
+
+func ResolveWorkspace(service ports.WorkspaceService) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			workspaceId := r.Header.Get(tenancy.Header)
+			principal, _ := auth.PrincipalFromContext(r.Context())
+
+			if principal.Workspace != "" {
+				if !domain.IsValidWorkspaceId(principal.Workspace) {
+					logger.FromContext(r.Context()).Warn("Token claims invalid workspace", zap.String("workspace", principal.Workspace))
+					writeError(w, r, errs.Localized(errs.NewForbiddenError, errs.MessageWorkspaceClaim))
+					return
+				}
+				if workspaceId != "" && workspaceId != principal.Workspace {
+					appErr := errs.Localized(errs.NewForbiddenError, errs.MessageWorkspaceRestricted, "id", principal.Workspace)
+					writeError(w, r, appErr)
+					return
+				}
+				workspaceId = principal.Workspace
+			} else if workspaceId != "" {
+				if _, appErr := service.GetWorkspaceById(r.Context(), workspaceId); appErr != nil {
+					writeError(w, r, appErr)
+					return
+				}
+			}
+
+			ctx := tenancy.WithWorkspace(r.Context(), workspaceId)
+			if workspaceId != "" {
+				ctx = logger.WithContext(ctx, logger.FromContext(ctx).With(zap.String("workspace", workspaceId)))
+			}
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+ * package: handlers
+ * --------------------
+ * Includes handler function definitions.
+ */
+
+package handlers
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/luschnat-ziegler/toDoListAPI/auth"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/tenancy"
+	"github.com/luschnat-ziegler/toDoListAPI/testUtils/mocks/ports"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+/*
+ * function: workspaceRouter
+ * --------------------
+ * Instantiates a router serving /todos behind the ResolveWorkspace middleware, recording the workspace seen by
+ * the handler. The workspace service knows the workspace "teama" only.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ * workspaceId: a pointer to the string to be set by the handler
+ *
+ * Returns: a pointer to the mux.Router
+ */
+
+func workspaceRouter(t *testing.T, workspaceId *string) *mux.Router {
+	service := ports.NewMockWorkspaceService(gomock.NewController(t))
+	service.EXPECT().GetWorkspaceById(gomock.Any(), "teama").Return(&domain.Workspace{Id: "teama"}, nil).AnyTimes()
+	service.EXPECT().GetWorkspaceById(gomock.Any(), gomock.Not("teama")).
+		Return(nil, errs.NewNotFoundError("No workspace matching id")).
+		AnyTimes()
+
+	workspaceRouter := mux.NewRouter()
+	workspaceRouter.Use(ResolveWorkspace(service))
+	workspaceRouter.HandleFunc("/todos", func(w http.ResponseWriter, r *http.Request) {
+		*workspaceId = tenancy.WorkspaceFromContext(r.Context())
+	})
+	return workspaceRouter
+}
+
+/*
+ * function: Test_ResolveWorkspace_should_use_workspace_from_header_or_default
+ * --------------------
+ * Tests if the workspace named in the header is used if known, unknown workspaces are answered with code 404 and
+ * requests without header use the default workspace.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ResolveWorkspace_should_use_workspace_from_header_or_default(t *testing.T) {
+	for header, expected := range map[string]struct {
+		code        int
+		workspaceId string
+	}{
+		"teama": {http.StatusOK, "teama"},
+		"teamb": {http.StatusNotFound, ""},
+		"":      {http.StatusOK, ""},
+	} {
+		workspaceId := "unset"
+		request, _ := http.NewRequest(http.MethodGet, "/todos", nil)
+		if header != "" {
+			request.Header.Set(tenancy.Header, header)
+		}
+		recorder := httptest.NewRecorder()
+		workspaceRouter(t, &workspaceId).ServeHTTP(recorder, request)
+
+		if recorder.Code != expected.code {
+			t.Errorf("Header %q: expected code %v, got %v instead", header, expected.code, recorder.Code)
+		}
+		if expected.code == http.StatusOK && workspaceId != expected.workspaceId {
+			t.Errorf("Header %q: expected workspace %q, got %q instead", header, expected.workspaceId, workspaceId)
+		}
+	}
+}
+
+/*
+ * function: Test_ResolveWorkspace_should_enforce_workspace_of_token
+ * --------------------
+ * Tests if the workspace claimed by the token is used without a header and requests naming another workspace in
+ * the header are rejected with code 403.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ResolveWorkspace_should_enforce_workspace_of_token(t *testing.T) {
+	workspaceId := "unset"
+	ctx := auth.WithPrincipal(context.Background(), auth.Principal{Subject: "alice", Method: auth.MethodJWT, Workspace: "teamc"})
+
+	request, _ := http.NewRequestWithContext(ctx, http.MethodGet, "/todos", nil)
+	recorder := httptest.NewRecorder()
+	workspaceRouter(t, &workspaceId).ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusOK || workspaceId != "teamc" {
+		t.Errorf("Expected code 200 and workspace teamc, got %v and %q instead", recorder.Code, workspaceId)
+	}
+
+	request, _ = http.NewRequestWithContext(ctx, http.MethodGet, "/todos", nil)
+	request.Header.Set(tenancy.Header, "teama")
+	recorder = httptest.NewRecorder()
+	workspaceRouter(t, &workspaceId).ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusForbidden {
+		t.Errorf("Expected code 403, got %v instead", recorder.Code)
+	}
+}
+
+/*
+ * function: Test_ResolveWorkspace_should_reject_invalid_workspace_of_token
+ * --------------------
+ * Tests if a workspace claimed by the token that does not meet the rules for workspace ids is rejected with code
+ * 403 instead of being used, as it would be appended to database names.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ResolveWorkspace_should_reject_invalid_workspace_of_token(t *testing.T) {
+	for _, claim := range []string{"TeamC", "team.c", "admin/x", "a23456789012345678901234567890123"} {
+		workspaceId := "unset"
+		ctx := auth.WithPrincipal(context.Background(), auth.Principal{Subject: "alice", Method: auth.MethodJWT, Workspace: claim})
+
+		request, _ := http.NewRequestWithContext(ctx, http.MethodGet, "/todos", nil)
+		recorder := httptest.NewRecorder()
+		workspaceRouter(t, &workspaceId).ServeHTTP(recorder, request)
+
+		if recorder.Code != http.StatusForbidden || workspaceId != "unset" {
+			t.Errorf("Claim %q: expected code 403 without calling the handler, got %v and %q instead", claim, recorder.Code, workspaceId)
+		}
+	}
+}
@@ -6,26 +6,184 @@
 
 package logger
 
-import "go.uber.org/zap"
+import (
+	"context"
+	"github.com/luschnat-ziegler/toDoListAPI/config"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+	"os"
+	"time"
+)
+
+type contextKey struct{}
 
 var log *zap.Logger
+var base *zap.Logger
+var level = zap.NewAtomicLevelAt(zap.InfoLevel)
 
 /*
  * Function: init
  * --------------------
- * Initiates a logger instance and refers caller info one step up the chain.
+ * Initiates a logger instance with the default settings (see config.Default), i.e. sampled JSON output to stderr
+ * at an adjustable level (info by default, see SetLevel), until Configure is called.
  *
  * returns: nothing
  */
 
 func init() {
-	var err error
-	log, err = zap.NewProduction(zap.AddCallerSkip(1))
-	if err != nil {
+	if err := Configure(config.Default().Log); err != nil {
 		panic(err)
 	}
 }
 
+/*
+ * Function: Configure
+ * --------------------
+ * Replaces the logger according to the given settings: level, encoding ("json" or "console"), output (stderr or a
+ * file rotated by size and age) and sampling. To be called once at startup, before requests are served. Loggers
+ * previously obtained from FromContext keep the former output.
+ *
+ * settings: the config.Log to be applied
+ *
+ * returns: an error if the level is invalid, nil otherwise
+ */
+
+func Configure(settings config.Log) error {
+	if err := SetLevel(settings.Level); err != nil {
+		return err
+	}
+
+	var encoder zapcore.Encoder
+	if settings.Format == "console" {
+		encoderConfig := zap.NewDevelopmentEncoderConfig()
+		encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+		encoder = zapcore.NewConsoleEncoder(encoderConfig)
+	} else {
+		encoder = zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig())
+	}
+
+	var output zapcore.WriteSyncer = zapcore.Lock(os.Stderr)
+	if settings.File != "" {
+		output = zapcore.AddSync(&lumberjack.Logger{
+			Filename:   settings.File,
+			MaxSize:    settings.MaxSizeMB,
+			MaxBackups: settings.MaxBackups,
+			MaxAge:     settings.MaxAgeDays,
+		})
+	}
+
+	core := zapcore.NewCore(encoder, output, level)
+	if settings.SamplingInitial > 0 {
+		core = zapcore.NewSamplerWithOptions(core, time.Second, settings.SamplingInitial, settings.SamplingThereafter)
+	}
+
+	install(core)
+	return nil
+}
+
+/*
+ * Function: ReplaceCore
+ * --------------------
+ * Replaces the core of the logger, e.g. by an observer core (see go.uber.org/zap/zaptest/observer) to assert on
+ * log output in tests, or by zapcore.NewNopCore() to silence it. The level of the given core applies.
+ *
+ * core: the zapcore.Core to write log entries to
+ *
+ * returns: a function restoring the former core
+ */
+
+func ReplaceCore(core zapcore.Core) func() {
+	previous := base.Core()
+	install(core)
+	return func() {
+		install(previous)
+	}
+}
+
+/*
+ * Function: install
+ * --------------------
+ * Builds the application logger on top of a core. The package level functions refer caller info one step up the
+ * chain.
+ *
+ * core: the zapcore.Core to write log entries to
+ *
+ * returns: nothing
+ */
+
+func install(core zapcore.Core) {
+	base = zap.New(core, zap.AddCaller(), zap.AddStacktrace(zap.ErrorLevel))
+	log = base.WithOptions(zap.AddCallerSkip(1))
+}
+
+/*
+ * Function: SetLevel
+ * --------------------
+ * Changes the minimum level of log output.
+ *
+ * name: the name of the level, e.g. "debug", "info", "warn" or "error"
+ *
+ * returns: an error if the name is not a valid level, nil otherwise
+ */
+
+func SetLevel(name string) error {
+	var l zapcore.Level
+	if err := l.UnmarshalText([]byte(name)); err != nil {
+		return err
+	}
+	level.SetLevel(l)
+	return nil
+}
+
+/*
+ * Function: WithContext
+ * --------------------
+ * Stores a logger in a context, e.g. one carrying fields of the current request.
+ *
+ * ctx: the parent context.Context
+ * l: a pointer to the zap.Logger to be stored
+ *
+ * returns: a context.Context carrying the logger
+ */
+
+func WithContext(ctx context.Context, l *zap.Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, l)
+}
+
+/*
+ * Function: FromContext
+ * --------------------
+ * Retrieves the logger stored in a context (see WithContext), falling back to the application logger if there is
+ * none. The returned logger shares level and output with the application logger.
+ *
+ * ctx: a context.Context
+ *
+ * returns: a pointer to a zap.Logger
+ */
+
+func FromContext(ctx context.Context) *zap.Logger {
+	if l, ok := ctx.Value(contextKey{}).(*zap.Logger); ok {
+		return l
+	}
+	return base
+}
+
+/*
+ * Function: Debug
+ * --------------------
+ * Creates a Debug log output using the initiated logger instance
+ *
+ * message: A string with logging info
+ * fields: Any number of zap.Field
+ *
+ * returns: nothing
+ */
+
+func Debug(message string, fields ...zap.Field) {
+	log.Debug(message, fields...)
+}
+
 /*
  * Function: Info
  * --------------------
@@ -41,6 +199,21 @@ func Info(message string, fields ...zap.Field) {
 	log.Info(message, fields...)
 }
 
+/*
+ * Function: Warn
+ * --------------------
+ * Creates a Warn log output using the initiated logger instance
+ *
+ * message: A string with logging info
+ * fields: Any number of zap.Field
+ *
+ * returns: nothing
+ */
+
+func Warn(message string, fields ...zap.Field) {
+	log.Warn(message, fields...)
+}
+
 /*
  * Function: Error
  * --------------------
@@ -55,3 +228,15 @@ func Info(message string, fields ...zap.Field) {
 func Error(message string, fields ...zap.Field) {
 	log.Error(message, fields...)
 }
+
+/*
+ * Function: Sync
+ * --------------------
+ * Flushes buffered log entries. To be called before the application exits.
+ *
+ * returns: nothing
+ */
+
+func Sync() {
+	_ = base.Sync()
+}
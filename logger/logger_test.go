@@ -0,0 +1,111 @@
+/*
+ * package: logger
+ * --------------------
+ * Includes a custom logger (using go.uber.org/zap)
+ */
+
+package logger
+
+import (
+	"github.com/luschnat-ziegler/toDoListAPI/config"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+/*
+ * function: Test_ReplaceCore_should_route_entries_to_observer_until_restored
+ * --------------------
+ * Tests if entries are written to a replacing observer core, including those of request-scoped loggers, and if the
+ * former core is used again after restoring.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ReplaceCore_should_route_entries_to_observer_until_restored(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	restore := ReplaceCore(core)
+
+	Debug("debug message")
+	Warn("warn message", zap.String("key", "value"))
+
+	restore()
+	Info("after restore")
+
+	entries := logs.AllUntimed()
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %v instead", len(entries))
+	}
+	if entries[0].Level != zapcore.DebugLevel || entries[0].Message != "debug message" {
+		t.Errorf("Unexpected first entry %+v", entries[0])
+	}
+	if entries[1].Level != zapcore.WarnLevel || entries[1].ContextMap()["key"] != "value" {
+		t.Errorf("Unexpected second entry %+v", entries[1])
+	}
+}
+
+/*
+ * function: Test_Configure_should_write_console_output_to_file
+ * --------------------
+ * Tests if Configure applies level, console encoding and file output.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Configure_should_write_console_output_to_file(t *testing.T) {
+	restore := ReplaceCore(base.Core())
+	defer restore()
+	defer func() { _ = SetLevel("info") }()
+
+	settings := config.Default().Log
+	settings.Level = "warn"
+	settings.Format = "console"
+	settings.File = filepath.Join(t.TempDir(), "todo.log")
+
+	if err := Configure(settings); err != nil {
+		t.Fatalf("Expected nil, got error %v instead", err)
+	}
+
+	Info("filtered message")
+	Warn("written message")
+
+	content, err := os.ReadFile(settings.File)
+	if err != nil {
+		t.Fatal(err)
+	}
+	output := string(content)
+
+	if strings.Contains(output, "filtered message") {
+		t.Error("Expected info message to be filtered")
+	}
+	if !strings.Contains(output, "\tWARN\t") || !strings.Contains(output, "written message") {
+		t.Errorf("Expected console formatted warn message, got %q instead", output)
+	}
+}
+
+/*
+ * function: Test_Configure_should_reject_invalid_level
+ * --------------------
+ * Tests if Configure returns an error for an unknown level.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Configure_should_reject_invalid_level(t *testing.T) {
+	settings := config.Default().Log
+	settings.Level = "verbose"
+
+	if err := Configure(settings); err == nil {
+		t.Error("Expected error, got nil instead")
+	}
+}
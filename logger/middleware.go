@@ -0,0 +1,115 @@
+/*
+ * package: logger
+ * --------------------
+ * Includes a custom logger (using go.uber.org/zap)
+ */
+
+package logger
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"net/http"
+	"regexp"
+	"time"
+)
+
+const RequestIDHeader = "X-Request-ID"
+
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+type requestIDKey struct{}
+
+type responseRecorder struct {
+	http.ResponseWriter
+	code  int
+	bytes int
+}
+
+/*
+ * Method: responseRecorder.WriteHeader
+ * --------------------
+ * Remembers the status code before passing it on to the wrapped http.ResponseWriter.
+ *
+ * code: the status code of the response
+ *
+ * returns: nothing
+ */
+
+func (rr *responseRecorder) WriteHeader(code int) {
+	rr.code = code
+	rr.ResponseWriter.WriteHeader(code)
+}
+
+/*
+ * Method: responseRecorder.Write
+ * --------------------
+ * Counts the written bytes before passing them on to the wrapped http.ResponseWriter.
+ *
+ * p: the bytes to be written
+ *
+ * returns: the number of bytes written and an error if writing failed
+ */
+
+func (rr *responseRecorder) Write(p []byte) (int, error) {
+	n, err := rr.ResponseWriter.Write(p)
+	rr.bytes += n
+	return n, err
+}
+
+/*
+ * Function: Middleware
+ * --------------------
+ * Wraps a handler to assign every request an id and a request-scoped logger. The id is taken from the X-Request-ID
+ * header if the client (or a proxy) sent a valid one and generated otherwise. It is echoed in the response header
+ * and added as field "request_id" to the logger stored in the request context (see FromContext). After the request
+ * is served, an access log line is written.
+ *
+ * next: the http.Handler to be wrapped
+ *
+ * returns: the wrapping http.Handler
+ */
+
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		requestID := r.Header.Get(RequestIDHeader)
+		if !validRequestID.MatchString(requestID) {
+			requestID = uuid.NewString()
+		}
+		w.Header().Set(RequestIDHeader, requestID)
+
+		requestLogger := base.With(zap.String("request_id", requestID))
+		ctx := WithContext(r.Context(), requestLogger)
+		ctx = context.WithValue(ctx, requestIDKey{}, requestID)
+
+		recorder := &responseRecorder{ResponseWriter: w, code: http.StatusOK}
+		next.ServeHTTP(recorder, r.WithContext(ctx))
+
+		requestLogger.Info("Request handled",
+			zap.String("method", r.Method),
+			zap.String("path", r.URL.Path),
+			zap.Int("status", recorder.code),
+			zap.Int("bytes", recorder.bytes),
+			zap.Duration("duration", time.Since(start)),
+			zap.String("remote_addr", r.RemoteAddr),
+		)
+	})
+}
+
+/*
+ * Function: RequestID
+ * --------------------
+ * Retrieves the id assigned to a request by Middleware.
+ *
+ * ctx: the context.Context of the request
+ *
+ * returns: the request id, or an empty string if there is none
+ */
+
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
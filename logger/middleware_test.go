@@ -0,0 +1,131 @@
+/*
+ * package: logger
+ * --------------------
+ * Includes a custom logger (using go.uber.org/zap)
+ */
+
+package logger
+
+import (
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+/*
+ * function: serveWithRequestID
+ * --------------------
+ * Serves a request through Middleware and captures the request id and logger seen by the wrapped handler as well
+ * as the log entries written.
+ *
+ * header: the value of the X-Request-ID request header, omitted if empty
+ *
+ * returns: the response recorder, the request id, whether the handler saw a request-scoped logger and the observed
+ *          log entries
+ */
+
+func serveWithRequestID(header string) (*httptest.ResponseRecorder, string, bool, *observer.ObservedLogs) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	defer ReplaceCore(core)()
+
+	var requestID string
+	var scoped bool
+	handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID = RequestID(r.Context())
+		scoped = FromContext(r.Context()) != base
+		w.WriteHeader(http.StatusTeapot)
+	}))
+
+	request, _ := http.NewRequest(http.MethodGet, "/todos", nil)
+	if header != "" {
+		request.Header.Set(RequestIDHeader, header)
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	return recorder, requestID, scoped, logs
+}
+
+/*
+ * function: Test_Middleware_should_propagate_valid_request_id
+ * --------------------
+ * Tests if the middleware keeps a valid X-Request-ID sent by the client, echoes it in the response header,
+ * provides it and a request-scoped logger to the wrapped handler and writes an access log entry carrying it.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Middleware_should_propagate_valid_request_id(t *testing.T) {
+	recorder, requestID, scoped, logs := serveWithRequestID("abc-123")
+
+	if requestID != "abc-123" {
+		t.Errorf("Expected request id abc-123, got %v instead", requestID)
+	}
+
+	if header := recorder.Header().Get(RequestIDHeader); header != "abc-123" {
+		t.Errorf("Expected response header abc-123, got %v instead", header)
+	}
+
+	if !scoped {
+		t.Error("Expected a request-scoped logger in the context")
+	}
+
+	if recorder.Code != http.StatusTeapot {
+		t.Errorf("Expected code 418, got %v instead", recorder.Code)
+	}
+
+	accessLogs := logs.FilterMessage("Request handled").AllUntimed()
+	if len(accessLogs) != 1 {
+		t.Fatalf("Expected 1 access log entry, got %v instead", len(accessLogs))
+	}
+	fields := accessLogs[0].ContextMap()
+	if fields["request_id"] != "abc-123" || fields["status"] != int64(http.StatusTeapot) || fields["path"] != "/todos" {
+		t.Errorf("Unexpected access log fields %v", fields)
+	}
+}
+
+/*
+ * function: Test_Middleware_should_generate_request_id_if_missing_or_invalid
+ * --------------------
+ * Tests if the middleware generates a new request id if the client sent none or an invalid one.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Middleware_should_generate_request_id_if_missing_or_invalid(t *testing.T) {
+	for _, header := range []string{"", "contains spaces\nand newlines"} {
+		recorder, requestID, _, _ := serveWithRequestID(header)
+
+		if requestID == "" || requestID == header {
+			t.Errorf("Expected generated request id for header %q, got %q instead", header, requestID)
+		}
+
+		if recorder.Header().Get(RequestIDHeader) != requestID {
+			t.Errorf("Expected response header %v, got %v instead", requestID, recorder.Header().Get(RequestIDHeader))
+		}
+	}
+}
+
+/*
+ * function: Test_FromContext_should_fall_back_to_application_logger
+ * --------------------
+ * Tests if FromContext returns the application logger for contexts without a stored logger.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_FromContext_should_fall_back_to_application_logger(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	if FromContext(request.Context()) != base {
+		t.Error("Expected application logger")
+	}
+}
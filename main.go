@@ -2,8 +2,10 @@ package main
 
 import (
 	"github.com/joho/godotenv"
+	"github.com/luschnat-ziegler/toDoListAPI/config"
 	"github.com/luschnat-ziegler/toDoListAPI/logger"
 	"github.com/luschnat-ziegler/toDoListAPI/server"
+	"os"
 )
 
 /*
@@ -20,6 +22,32 @@ func init() {
 	}
 }
 
+/*
+ * function: main
+ * --------------------
+ * Loads and validates the configuration (see config.Load), configures the logger and either runs the command
+ * given as argument or starts the server. Exits with status 1 if the configuration is invalid. Buffered log
+ * entries are flushed before exiting.
+ *
+ * returns: nothing
+ */
+
 func main() {
-	server.Start()
+	cfg, err := config.Load()
+	if err != nil {
+		logger.Error(err.Error() + ". Terminating application...")
+		logger.Sync()
+		os.Exit(1)
+	}
+	if err := logger.Configure(cfg.Log); err != nil {
+		logger.Error("Invalid log settings: " + err.Error())
+	}
+
+	if len(os.Args) > 1 {
+		code := server.RunCommand(cfg, os.Args[1:])
+		logger.Sync()
+		os.Exit(code)
+	}
+	server.Start(cfg)
+	logger.Sync()
 }
@@ -0,0 +1,121 @@
+/*
+ * package: metrics
+ * --------------------
+ * Includes Prometheus metrics for http requests and repository operations as well as the handler exposing them.
+ */
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const namespace = "todolist"
+
+var (
+	registry = prometheus.NewRegistry()
+
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Number of handled http requests by method, route template and status code.",
+	}, []string{"method", "route", "code"})
+
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of handled http requests by method, route template and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "code"})
+
+	repositoryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "repository",
+		Name:      "operation_duration_seconds",
+		Help:      "Latency of repository operations by method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	repositoryErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "repository",
+		Name:      "operation_errors_total",
+		Help:      "Number of failed repository operations by method and error code.",
+	}, []string{"method", "code"})
+)
+
+/*
+ * Function: init
+ * --------------------
+ * Registers the application metrics as well as the Go runtime and process collectors.
+ *
+ * returns: nothing
+ */
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests,
+		httpRequestDuration,
+		repositoryDuration,
+		repositoryErrors,
+	)
+}
+
+/*
+ * Function: Handler
+ * --------------------
+ * Returns the handler exposing all registered metrics in the Prometheus text format.
+ *
+ * returns: an http.Handler
+ */
+
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+/*
+ * Function: ObserveRequest
+ * --------------------
+ * Records a handled http request.
+ *
+ * method: the http method of the request
+ * route: the route template the request matched, e.g. "/todos/{id}"
+ * code: the status code written to the response
+ * start: the time the request was received
+ *
+ * returns: nothing
+ */
+
+func ObserveRequest(method string, route string, code int, start time.Time) {
+	status := strconv.Itoa(code)
+	httpRequests.WithLabelValues(method, route, status).Inc()
+	httpRequestDuration.WithLabelValues(method, route, status).Observe(time.Since(start).Seconds())
+}
+
+/*
+ * Function: ObserveRepositoryOperation
+ * --------------------
+ * Records a repository operation and counts it as error if an error code is given.
+ *
+ * method: the name of the repository method
+ * start: the time the operation was started
+ * errorCode: the code of the errs.AppError returned by the operation, 0 on success
+ *
+ * returns: nothing
+ */
+
+func ObserveRepositoryOperation(method string, start time.Time, errorCode int) {
+	repositoryDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	if errorCode != 0 {
+		repositoryErrors.WithLabelValues(method, strconv.Itoa(errorCode)).Inc()
+	}
+}
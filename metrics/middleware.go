@@ -0,0 +1,109 @@
+/*
+ * package: metrics
+ * --------------------
+ * Includes Prometheus metrics for http requests and repository operations as well as the handler exposing them.
+ */
+
+package metrics
+
+import (
+	"context"
+	"github.com/gorilla/mux"
+	"net/http"
+	"regexp"
+	"time"
+)
+
+const unmatchedRoute = "unmatched"
+
+var routeVariablePattern = regexp.MustCompile(`\{(\w+):[^/]+\}`)
+
+type routeKey struct{}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	code int
+}
+
+/*
+ * Method: statusRecorder.WriteHeader
+ * --------------------
+ * Remembers the status code before passing it on to the wrapped http.ResponseWriter.
+ *
+ * code: the status code of the response
+ *
+ * returns: nothing
+ */
+
+func (sr *statusRecorder) WriteHeader(code int) {
+	sr.code = code
+	sr.ResponseWriter.WriteHeader(code)
+}
+
+/*
+ * Function: Middleware
+ * --------------------
+ * Wraps a handler to record count and latency of every request it serves. Requests are labelled with the route
+ * template of the matched mux route (see Route) instead of the path, so ids do not create a time series each;
+ * requests matching no route (404, 405) are labelled "unmatched". To wrap the whole handler chain, outside of
+ * handlers.Recover, so that panics answered with 500 are recorded as well.
+ *
+ * next: the http.Handler to be wrapped
+ *
+ * returns: the wrapping http.Handler
+ */
+
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		route := unmatchedRoute
+		recorder := &statusRecorder{ResponseWriter: w, code: http.StatusOK}
+
+		next.ServeHTTP(recorder, r.WithContext(context.WithValue(r.Context(), routeKey{}, &route)))
+
+		ObserveRequest(r.Method, route, recorder.code, start)
+	})
+}
+
+/*
+ * Function: Route
+ * --------------------
+ * Wraps a handler to pass the route template of the matched mux route on to Middleware, which wraps the router and
+ * therefore cannot determine it itself. To be registered with mux.Router.Use.
+ *
+ * next: the http.Handler to be wrapped
+ *
+ * returns: the wrapping http.Handler
+ */
+
+func Route(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if route, ok := r.Context().Value(routeKey{}).(*string); ok {
+			*route = routeTemplate(r)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+/*
+ * Function: routeTemplate
+ * --------------------
+ * Determines the path template of the mux route matched by a request. Patterns of route variables are stripped,
+ * e.g. "/todos/{id:[0-9a-fA-F]{24}}" is reported as "/todos/{id}".
+ *
+ * r: a pointer to the http.Request
+ *
+ * returns: the path template, or "unmatched" if the request did not match any route
+ */
+
+func routeTemplate(r *http.Request) string {
+	route := mux.CurrentRoute(r)
+	if route == nil {
+		return unmatchedRoute
+	}
+	template, err := route.GetPathTemplate()
+	if err != nil {
+		return unmatchedRoute
+	}
+	return routeVariablePattern.ReplaceAllString(template, "{$1}")
+}
@@ -0,0 +1,134 @@
+/*
+ * package: metrics
+ * --------------------
+ * Includes Prometheus metrics for http requests and repository operations as well as the handler exposing them.
+ */
+
+package metrics
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+/*
+ * function: Test_Middleware_should_label_requests_with_route_template_and_code
+ * --------------------
+ * Tests if the middleware counts requests by route template (without variable patterns) instead of path and records
+ * the status code written by the wrapped handler.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Middleware_should_label_requests_with_route_template_and_code(t *testing.T) {
+	router := mux.NewRouter()
+	router.Use(Route)
+	router.HandleFunc("/test/{id:[a-z]}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	handler := Middleware(router)
+
+	before := testutil.ToFloat64(httpRequests.WithLabelValues(http.MethodGet, "/test/{id}", "404"))
+
+	for _, id := range []string{"a", "b"} {
+		request, _ := http.NewRequest(http.MethodGet, "/test/"+id, nil)
+		handler.ServeHTTP(httptest.NewRecorder(), request)
+	}
+
+	after := testutil.ToFloat64(httpRequests.WithLabelValues(http.MethodGet, "/test/{id}", "404"))
+	if after-before != 2 {
+		t.Errorf("Expected 2 counted requests, got %v instead", after-before)
+	}
+}
+
+/*
+ * function: Test_Middleware_should_record_unmatched_requests_and_recovered_panics
+ * --------------------
+ * Tests if requests matching no route (404, 405) are counted as unmatched and panics answered with 500 by a
+ * recovering handler inside the middleware are counted with their route.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Middleware_should_record_unmatched_requests_and_recovered_panics(t *testing.T) {
+	router := mux.NewRouter()
+	router.Use(Route)
+	router.HandleFunc("/panic", func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}).Methods(http.MethodGet)
+	recovering := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if recover() != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		}()
+		router.ServeHTTP(w, r)
+	})
+	handler := Middleware(recovering)
+
+	for _, test := range []struct {
+		method string
+		path   string
+		route  string
+		code   string
+	}{
+		{http.MethodGet, "/panic", "/panic", "500"},
+		{http.MethodGet, "/unknown", unmatchedRoute, "404"},
+		{http.MethodPost, "/panic", unmatchedRoute, "405"},
+	} {
+		before := testutil.ToFloat64(httpRequests.WithLabelValues(test.method, test.route, test.code))
+
+		request, _ := http.NewRequest(test.method, test.path, nil)
+		handler.ServeHTTP(httptest.NewRecorder(), request)
+
+		if after := testutil.ToFloat64(httpRequests.WithLabelValues(test.method, test.route, test.code)); after-before != 1 {
+			t.Errorf("Expected %s %s to be counted as %s with code %s", test.method, test.path, test.route, test.code)
+		}
+	}
+}
+
+/*
+ * function: Test_Handler_should_expose_application_metrics
+ * --------------------
+ * Tests if the handler writes the registered application metrics in the Prometheus text format.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Handler_should_expose_application_metrics(t *testing.T) {
+	ObserveRequest(http.MethodGet, "/todos", http.StatusOK, time.Now())
+	ObserveRepositoryOperation("GetAll", time.Now(), 500)
+
+	request, _ := http.NewRequest(http.MethodGet, "/metrics", nil)
+	recorder := httptest.NewRecorder()
+
+	Handler().ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusOK {
+		t.Errorf("Expected code 200, got %v instead", recorder.Code)
+	}
+
+	body := recorder.Body.String()
+	for _, name := range []string{
+		"todolist_http_requests_total",
+		"todolist_http_request_duration_seconds_bucket",
+		"todolist_repository_operation_duration_seconds_bucket",
+		`todolist_repository_operation_errors_total{code="500",method="GetAll"}`,
+		"go_goroutines",
+	} {
+		if !strings.Contains(body, name) {
+			t.Errorf("Expected metric %v to be exposed", name)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+/*
+ * package: ratelimit
+ * --------------------
+ * Includes token bucket rate limiting of clients.
+ */
+
+package ratelimit
+
+import (
+	"github.com/luschnat-ziegler/toDoListAPI/config"
+	"math"
+	"sync"
+	"time"
+)
+
+const sweepInterval = time.Minute
+
+type Limiter struct {
+	rate      float64
+	burst     int
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+type Decision struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	Reset      time.Duration
+	RetryAfter time.Duration
+}
+
+/*
+ * Function: NewLimiter
+ * --------------------
+ * Instantiates a Limiter granting every client a bucket of limit.Burst tokens, refilled at limit.PerMinute tokens
+ * per minute. Every request takes one token.
+ *
+ * limit: the config.Limit to be applied
+ *
+ * returns: a pointer to the Limiter
+ */
+
+func NewLimiter(limit config.Limit) *Limiter {
+	return &Limiter{
+		rate:    float64(limit.PerMinute) / time.Minute.Seconds(),
+		burst:   limit.Burst,
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+/*
+ * Method: Limiter.Allow
+ * --------------------
+ * Takes a token from the bucket of a client, if one is left. Buckets are created full on the first request of a
+ * client. Safe for concurrent use.
+ *
+ * key: the key identifying the client
+ *
+ * returns: a Decision stating whether the request is allowed, the tokens remaining, the time until the bucket is
+ *          full again and, for denied requests, the time until the next token is available
+ */
+
+func (limiter *Limiter) Allow(key string) Decision {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	now := limiter.now()
+	if now.Sub(limiter.lastSweep) >= sweepInterval {
+		limiter.sweep(now)
+	}
+
+	b, ok := limiter.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limiter.burst), updated: now}
+		limiter.buckets[key] = b
+	}
+	b.tokens = limiter.refill(b, now)
+	b.updated = now
+
+	decision := Decision{Limit: limiter.burst}
+	if b.tokens >= 1 {
+		b.tokens--
+		decision.Allowed = true
+	} else {
+		decision.RetryAfter = limiter.duration(1 - b.tokens)
+	}
+	decision.Remaining = int(math.Floor(b.tokens))
+	decision.Reset = limiter.duration(float64(limiter.burst) - b.tokens)
+	return decision
+}
+
+/*
+ * Method: Limiter.refill
+ * --------------------
+ * Calculates the tokens of a bucket at a point in time, capped at the burst size.
+ *
+ * b: a pointer to the bucket
+ * now: the point in time
+ *
+ * returns: the number of tokens
+ */
+
+func (limiter *Limiter) refill(b *bucket, now time.Time) float64 {
+	return math.Min(float64(limiter.burst), b.tokens+now.Sub(b.updated).Seconds()*limiter.rate)
+}
+
+/*
+ * Method: Limiter.duration
+ * --------------------
+ * Calculates the time needed to refill a number of tokens.
+ *
+ * tokens: the number of tokens
+ *
+ * returns: the time.Duration, rounded up to whole seconds
+ */
+
+func (limiter *Limiter) duration(tokens float64) time.Duration {
+	return time.Duration(math.Ceil(tokens/limiter.rate)) * time.Second
+}
+
+/*
+ * Method: Limiter.sweep
+ * --------------------
+ * Drops the buckets that have been refilled completely, so that clients seen once do not take up memory. Must be
+ * called with the lock held.
+ *
+ * now: the current time
+ *
+ * returns: nothing
+ */
+
+func (limiter *Limiter) sweep(now time.Time) {
+	for key, b := range limiter.buckets {
+		if limiter.refill(b, now) >= float64(limiter.burst) {
+			delete(limiter.buckets, key)
+		}
+	}
+	limiter.lastSweep = now
+}
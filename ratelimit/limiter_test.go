@@ -0,0 +1,89 @@
+/*
+ * package: ratelimit
+ * --------------------
+ * Includes token bucket rate limiting of clients.
+ */
+
+package ratelimit
+
+import (
+	"github.com/luschnat-ziegler/toDoListAPI/config"
+	"testing"
+	"time"
+)
+
+/*
+ * function: newTestLimiter
+ * --------------------
+ * Instantiates a Limiter reading the time from a variable controlled by the test.
+ *
+ * limit: the config.Limit to be applied
+ * now: a pointer to the current time
+ *
+ * Returns: a pointer to the Limiter
+ */
+
+func newTestLimiter(limit config.Limit, now *time.Time) *Limiter {
+	limiter := NewLimiter(limit)
+	limiter.now = func() time.Time { return *now }
+	return limiter
+}
+
+/*
+ * function: Test_Limiter_Allow_should_deny_requests_exceeding_burst_until_refilled
+ * --------------------
+ * Tests if a client may send burst requests at once, is denied with the time until the next token afterwards and
+ * is allowed again once a token has been refilled.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Limiter_Allow_should_deny_requests_exceeding_burst_until_refilled(t *testing.T) {
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := newTestLimiter(config.Limit{PerMinute: 6, Burst: 2}, &now)
+
+	for i, remaining := range []int{1, 0} {
+		decision := limiter.Allow("client")
+		if !decision.Allowed || decision.Remaining != remaining || decision.Limit != 2 {
+			t.Errorf("Request %d: expected allowed with %d remaining, got %+v", i, remaining, decision)
+		}
+	}
+
+	denied := limiter.Allow("client")
+	if denied.Allowed || denied.RetryAfter != 10*time.Second || denied.Reset != 20*time.Second {
+		t.Errorf("Expected denial with retry after 10s and reset after 20s, got %+v", denied)
+	}
+	if other := limiter.Allow("other"); !other.Allowed {
+		t.Error("Expected other client not to be limited")
+	}
+
+	now = now.Add(10 * time.Second)
+	if decision := limiter.Allow("client"); !decision.Allowed {
+		t.Errorf("Expected request to be allowed after refill, got %+v", decision)
+	}
+}
+
+/*
+ * function: Test_Limiter_Allow_should_drop_refilled_buckets
+ * --------------------
+ * Tests if buckets of clients that have not sent requests long enough to be refilled are removed.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Limiter_Allow_should_drop_refilled_buckets(t *testing.T) {
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := newTestLimiter(config.Limit{PerMinute: 60, Burst: 10}, &now)
+
+	limiter.Allow("once")
+	now = now.Add(2 * sweepInterval)
+	limiter.Allow("later")
+
+	if _, ok := limiter.buckets["once"]; ok || len(limiter.buckets) != 1 {
+		t.Errorf("Expected only the bucket of the recent client, got %d buckets", len(limiter.buckets))
+	}
+}
@@ -0,0 +1,64 @@
+/*
+ * package: repositories
+ * --------------------
+ * Includes repository implementation(s) (as defined in package ports)
+ */
+
+package repositories
+
+import (
+	"context"
+	"github.com/luschnat-ziegler/toDoListAPI/tracing"
+	"go.mongodb.org/mongo-driver/event"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"strconv"
+	"sync"
+)
+
+/*
+ * Function: newCommandMonitor
+ * --------------------
+ * Instantiates a monitor tracing every command sent to the database in a client span named after the command,
+ * e.g. "mongodb.find", as child of the span in the context of the operation.
+ *
+ * returns: a pointer to an event.CommandMonitor
+ */
+
+func newCommandMonitor() *event.CommandMonitor {
+	var spans sync.Map
+
+	key := func(connectionID string, requestID int64) string {
+		return connectionID + "/" + strconv.FormatInt(requestID, 10)
+	}
+
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, started *event.CommandStartedEvent) {
+			attributes := []attribute.KeyValue{
+				semconv.DBSystemMongoDB,
+				semconv.DBNamespace(started.DatabaseName),
+				semconv.DBOperationName(started.CommandName),
+			}
+			if element, err := started.Command.IndexErr(0); err == nil {
+				if collection, ok := element.Value().StringValueOK(); ok {
+					attributes = append(attributes, semconv.DBCollectionName(collection))
+				}
+			}
+			_, span := tracing.Start(ctx, "mongodb."+started.CommandName, attributes...)
+			spans.Store(key(started.ConnectionID, started.RequestID), span)
+		},
+		Succeeded: func(_ context.Context, succeeded *event.CommandSucceededEvent) {
+			if span, ok := spans.LoadAndDelete(key(succeeded.ConnectionID, succeeded.RequestID)); ok {
+				span.(trace.Span).End()
+			}
+		},
+		Failed: func(_ context.Context, failed *event.CommandFailedEvent) {
+			if span, ok := spans.LoadAndDelete(key(failed.ConnectionID, failed.RequestID)); ok {
+				span.(trace.Span).SetStatus(codes.Error, failed.Failure)
+				span.(trace.Span).End()
+			}
+		},
+	}
+}
@@ -8,63 +8,48 @@ package repositories
 
 import (
 	"context"
+	"github.com/luschnat-ziegler/toDoListAPI/config"
 	"github.com/luschnat-ziegler/toDoListAPI/logger"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
-	"os"
-	"time"
-)
-
-var client *mongo.Client
-var ctx context.Context
-var cancel context.CancelFunc
-var collection *mongo.Collection
-
-const (
-	dbName         = "todo"
-	collectionName = "lists"
 )
 
 /*
  * Function: connectDbClient
  * --------------------
- * Initiates a connection to mongoDB and sets the collection to the provided collectionName. Also instantiates a
- * context.Context (5s timeout) to be used with the client, as well as the respective context.CancelFunc.
+ * Initiates a mongoDB client for the configured url. The client maintains a connection pool and is meant to be
+ * shared by all operations until it is disconnected (see disconnectClient). Commands are traced (see
+ * newCommandMonitor).
  *
- * returns: An error or nil
+ * settings: the config.Database providing url and timeout
+ *
+ * returns: a pointer to the mongo.Client and nil, or nil and an error
  */
 
-func connectDbClient() error {
-
-	url, _ := os.LookupEnv("DB_URL")
-	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+func connectDbClient(settings config.Database) (*mongo.Client, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), settings.Timeout.Duration)
+	defer cancel()
 
-	var clientError error
-	client, clientError = mongo.Connect(ctx, options.Client().ApplyURI(url))
-	if clientError != nil {
-		logger.Error("Database init error: " + clientError.Error())
-		return clientError
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(settings.URL).SetMonitor(newCommandMonitor()))
+	if err != nil {
+		logger.Error("Database init error: " + err.Error())
+		return nil, err
 	}
 
-	collection = client.Database(dbName).Collection(collectionName)
-
-	return nil
+	return client, nil
 }
 
 /*
  * Function: disconnectClient
  * --------------------
- * Disconnects a mongo.Client.
+ * Disconnects a mongo.Client, closing all pooled connections. Errors are left to the caller to log.
  *
  * client: a pointer to the mongo.Client to be disconnected
- * ctx: a context.Context used with the client
+ * ctx: a context.Context limiting the time to wait for in-use connections
  *
- * returns: nothing
+ * returns: an error or nil
  */
 
-func disconnectClient(client *mongo.Client, ctx context.Context) {
-	err := client.Disconnect(ctx)
-	if err != nil {
-		logger.Error("Error disconnecting from db client: " + err.Error())
-	}
+func disconnectClient(client *mongo.Client, ctx context.Context) error {
+	return client.Disconnect(ctx)
 }
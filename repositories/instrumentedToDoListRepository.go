@@ -0,0 +1,184 @@
+/*
+ * package: repositories
+ * --------------------
+ * Includes repository implementation(s) (as defined in package ports)
+ */
+
+package repositories
+
+import (
+	"context"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/core/ports"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/metrics"
+	"time"
+)
+
+type InstrumentedToDoListRepository struct {
+	repository ports.ToDoListRepository
+}
+
+/*
+ * Function: NewInstrumentedToDoListRepository
+ * --------------------
+ * Decorates a repository to record timing and errors of every operation (see metrics.ObserveRepositoryOperation).
+ *
+ * repository: the ports.ToDoListRepository to be decorated
+ *
+ * returns: an InstrumentedToDoListRepository
+ */
+
+func NewInstrumentedToDoListRepository(repository ports.ToDoListRepository) InstrumentedToDoListRepository {
+	return InstrumentedToDoListRepository{repository: repository}
+}
+
+/*
+ * Function: observe
+ * --------------------
+ * Records a finished operation.
+ *
+ * method: the name of the repository method
+ * start: the time the operation was started
+ * appErr: the pointer to errs.AppError returned by the operation
+ *
+ * returns: nothing
+ */
+
+func observe(method string, start time.Time, appErr *errs.AppError) {
+	code := 0
+	if appErr != nil {
+		code = appErr.Code
+	}
+	metrics.ObserveRepositoryOperation(method, start, code)
+}
+
+/*
+ * Method: InstrumentedToDoListRepository.GetAll
+ * --------------------
+ * See ports.ToDoListRepository.
+ */
+
+func (r InstrumentedToDoListRepository) GetAll(ctx context.Context, subject string) (*[]domain.ToDoList, *errs.AppError) {
+	start := time.Now()
+	toDoLists, appErr := r.repository.GetAll(ctx, subject)
+	observe("GetAll", start, appErr)
+	return toDoLists, appErr
+}
+
+/*
+ * Method: InstrumentedToDoListRepository.GetOneById
+ * --------------------
+ * See ports.ToDoListRepository.
+ */
+
+func (r InstrumentedToDoListRepository) GetOneById(ctx context.Context, subject string, id domain.ListId) (*domain.ToDoList, *errs.AppError) {
+	start := time.Now()
+	toDoList, appErr := r.repository.GetOneById(ctx, subject, id)
+	observe("GetOneById", start, appErr)
+	return toDoList, appErr
+}
+
+/*
+ * Method: InstrumentedToDoListRepository.GetOneSharedById
+ * --------------------
+ * See ports.ToDoListRepository.
+ */
+
+func (r InstrumentedToDoListRepository) GetOneSharedById(ctx context.Context, id domain.ListId) (*domain.ToDoList, *errs.AppError) {
+	start := time.Now()
+	toDoList, appErr := r.repository.GetOneSharedById(ctx, id)
+	observe("GetOneSharedById", start, appErr)
+	return toDoList, appErr
+}
+
+/*
+ * Method: InstrumentedToDoListRepository.UpdateOneById
+ * --------------------
+ * See ports.ToDoListRepository.
+ */
+
+func (r InstrumentedToDoListRepository) UpdateOneById(ctx context.Context, subject string, id domain.ListId, toDoList domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
+	start := time.Now()
+	updated, appErr := r.repository.UpdateOneById(ctx, subject, id, toDoList)
+	observe("UpdateOneById", start, appErr)
+	return updated, appErr
+}
+
+/*
+ * Method: InstrumentedToDoListRepository.Save
+ * --------------------
+ * See ports.ToDoListRepository.
+ */
+
+func (r InstrumentedToDoListRepository) Save(ctx context.Context, toDoList domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
+	start := time.Now()
+	saved, appErr := r.repository.Save(ctx, toDoList)
+	observe("Save", start, appErr)
+	return saved, appErr
+}
+
+/*
+ * Method: InstrumentedToDoListRepository.DeleteOneById
+ * --------------------
+ * See ports.ToDoListRepository.
+ */
+
+func (r InstrumentedToDoListRepository) DeleteOneById(ctx context.Context, subject string, id domain.ListId) *errs.AppError {
+	start := time.Now()
+	appErr := r.repository.DeleteOneById(ctx, subject, id)
+	observe("DeleteOneById", start, appErr)
+	return appErr
+}
+
+/*
+ * Method: InstrumentedToDoListRepository.UpdateCollaborators
+ * --------------------
+ * See ports.ToDoListRepository.
+ */
+
+func (r InstrumentedToDoListRepository) UpdateCollaborators(ctx context.Context, subject string, id domain.ListId, collaborators []domain.Collaborator) (*domain.ToDoList, *errs.AppError) {
+	start := time.Now()
+	updated, appErr := r.repository.UpdateCollaborators(ctx, subject, id, collaborators)
+	observe("UpdateCollaborators", start, appErr)
+	return updated, appErr
+}
+
+/*
+ * Method: InstrumentedToDoListRepository.ForEach
+ * --------------------
+ * See ports.ToDoListRepository. The recorded time includes the time spent in fn.
+ */
+
+func (r InstrumentedToDoListRepository) ForEach(ctx context.Context, fn func(domain.ToDoList) error) *errs.AppError {
+	start := time.Now()
+	appErr := r.repository.ForEach(ctx, fn)
+	observe("ForEach", start, appErr)
+	return appErr
+}
+
+/*
+ * Method: InstrumentedToDoListRepository.Restore
+ * --------------------
+ * See ports.ToDoListRepository.
+ */
+
+func (r InstrumentedToDoListRepository) Restore(ctx context.Context, toDoList domain.ToDoList, overwrite bool) (bool, *errs.AppError) {
+	start := time.Now()
+	replaced, appErr := r.repository.Restore(ctx, toDoList, overwrite)
+	observe("Restore", start, appErr)
+	return replaced, appErr
+}
+
+/*
+ * Method: InstrumentedToDoListRepository.Ping
+ * --------------------
+ * See ports.ToDoListRepository.
+ */
+
+func (r InstrumentedToDoListRepository) Ping(ctx context.Context) *errs.AppError {
+	start := time.Now()
+	appErr := r.repository.Ping(ctx)
+	observe("Ping", start, appErr)
+	return appErr
+}
@@ -0,0 +1,48 @@
+/*
+ * package: repositories
+ * --------------------
+ * Includes repository implementation(s) (as defined in package ports)
+ */
+
+package repositories
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/testUtils/dummies"
+	"github.com/luschnat-ziegler/toDoListAPI/testUtils/mocks/ports"
+	"testing"
+)
+
+/*
+ * function: Test_InstrumentedToDoListRepository_should_pass_through_results
+ * --------------------
+ * Tests if the decorator calls the wrapped repository and returns its results unchanged, both on success and
+ * on error.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_InstrumentedToDoListRepository_should_pass_through_results(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := ports.NewMockToDoListRepository(ctrl)
+	repository := NewInstrumentedToDoListRepository(mockRepository)
+
+	dummyError := errs.NewNotFoundError("List not found")
+	mockRepository.EXPECT().GetOneById(gomock.Any(), "test_owner", dummies.DummyListId).Return(&dummies.DummyListValidWithIds, nil).Times(1)
+	mockRepository.EXPECT().DeleteOneById(gomock.Any(), "test_owner", dummies.DummyListId).Return(dummyError).Times(1)
+
+	toDoList, appErr := repository.GetOneById(context.Background(), "test_owner", dummies.DummyListId)
+	if appErr != nil || toDoList != &dummies.DummyListValidWithIds {
+		t.Errorf("Expected wrapped result, got %v and %v instead", toDoList, appErr)
+	}
+
+	if appErr := repository.DeleteOneById(context.Background(), "test_owner", dummies.DummyListId); appErr != dummyError {
+		t.Errorf("Expected wrapped error, got %v instead", appErr)
+	}
+}
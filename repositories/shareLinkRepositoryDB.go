@@ -0,0 +1,163 @@
+/*
+ * package: repositories
+ * --------------------
+ * Includes repository implementation(s) (as defined in package ports)
+ */
+
+package repositories
+
+import (
+	"context"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/logger"
+	"github.com/luschnat-ziegler/toDoListAPI/tenancy"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type ShareLinkRepositoryDB struct {
+	lists      ToDoListRepositoryDB
+	collection *mongo.Collection
+}
+
+/*
+ * Method: ShareLinkRepositoryDB.Save
+ * --------------------
+ * Saves one new share link in the database, in the workspace of the request (see tenancy.WorkspaceFromContext).
+ * The token itself is not persisted, only its hash.
+ *
+ * ctx: the context.Context of the request
+ * newLink: the new domain.ShareLink to be persisted.
+ *
+ * returns: a pointer to the domain.ShareLink and nil on success.
+ *          Otherwise, nil and a pointer to an errs.AppError are returned.
+ */
+
+func (shareLinkRepositoryDB ShareLinkRepositoryDB) Save(ctx context.Context, newLink domain.ShareLink) (*domain.ShareLink, *errs.AppError) {
+	ctx, cancel := shareLinkRepositoryDB.lists.newContext(ctx)
+	defer cancel()
+
+	newLink.WorkspaceId = tenancy.WorkspaceFromContext(ctx)
+	if _, err := shareLinkRepositoryDB.collection.InsertOne(ctx, newLink); err != nil {
+		logger.FromContext(ctx).Error("Error querying database: " + err.Error())
+		return nil, errs.NewInternalError("Database error")
+	}
+	return &newLink, nil
+}
+
+/*
+ * Method: ShareLinkRepositoryDB.GetAllByListId
+ * --------------------
+ * Retrieves all share links of a list of the workspace of the request from the database, including expired ones.
+ *
+ * ctx: the context.Context of the request
+ * listId: the id of the shared list
+ *
+ * returns: a pointer to a slice of domain.ShareLink and nil on success.
+ *          Otherwise, nil and a pointer to an errs.AppError are returned.
+ */
+
+func (shareLinkRepositoryDB ShareLinkRepositoryDB) GetAllByListId(ctx context.Context, listId string) (*[]domain.ShareLink, *errs.AppError) {
+	ctx, cancel := shareLinkRepositoryDB.lists.newContext(ctx)
+	defer cancel()
+
+	filter := workspaceFilter(ctx)
+	filter["listId"] = listId
+
+	cursor, err := shareLinkRepositoryDB.collection.Find(ctx, filter)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error querying database: " + err.Error())
+		return nil, errs.NewInternalError("Database Error")
+	}
+
+	output := []domain.ShareLink{}
+	if err := cursor.All(ctx, &output); err != nil {
+		logger.FromContext(ctx).Error("Error decoding database object: " + err.Error())
+		return nil, errs.NewInternalError("Database Error")
+	}
+
+	return &output, nil
+}
+
+/*
+ * Method: ShareLinkRepositoryDB.GetOneByTokenHash
+ * --------------------
+ * Retrieves one share link from the database by the hash of its token (see domain.HashShareToken), regardless of
+ * the workspace of the request. The workspace of the link is returned with it.
+ *
+ * ctx: the context.Context of the request
+ * tokenHash: the hash of the token
+ *
+ * returns: a pointer to a domain.ShareLink and nil on success.
+ *          Otherwise, nil and a pointer to an errs.AppError are returned.
+ */
+
+func (shareLinkRepositoryDB ShareLinkRepositoryDB) GetOneByTokenHash(ctx context.Context, tokenHash string) (*domain.ShareLink, *errs.AppError) {
+	ctx, cancel := shareLinkRepositoryDB.lists.newContext(ctx)
+	defer cancel()
+
+	var shareLink domain.ShareLink
+
+	err := shareLinkRepositoryDB.collection.FindOne(ctx, bson.M{"tokenHash": tokenHash}).Decode(&shareLink)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errs.Localized(errs.NewNotFoundError, errs.MessageShareLinkNotFound)
+		}
+		logger.FromContext(ctx).Error("Error querying database: " + err.Error())
+		return nil, errs.NewInternalError("Database error")
+	}
+	return &shareLink, nil
+}
+
+/*
+ * Method: ShareLinkRepositoryDB.DeleteOneById
+ * --------------------
+ * Deletes one share link of a list of the workspace of the request from the database.
+ *
+ * ctx: the context.Context of the request
+ * listId: the id of the shared list
+ * id: the id of the share link requested for deletion.
+ *
+ * returns: nil on success or a pointer to an errs.AppError on failure
+ */
+
+func (shareLinkRepositoryDB ShareLinkRepositoryDB) DeleteOneById(ctx context.Context, listId string, id string) *errs.AppError {
+	ctx, cancel := shareLinkRepositoryDB.lists.newContext(ctx)
+	defer cancel()
+
+	filter := workspaceFilter(ctx)
+	filter["_id"] = id
+	filter["listId"] = listId
+
+	result, err := shareLinkRepositoryDB.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error querying database: " + err.Error())
+		return errs.NewInternalError("Database error")
+	}
+
+	if result.DeletedCount == 0 {
+		return errs.Localized(errs.NewNotFoundError, errs.MessageShareLinkIdNotFound, "id", id)
+	}
+
+	return nil
+}
+
+/*
+ * Function: NewShareLinkRepositoryDB
+ * --------------------
+ * Instantiates a new ShareLinkRepositoryDB for dependency injection. Share links of all workspaces are held in the
+ * configured share link collection of the configured database, so that they can be looked up by token. The
+ * mongo.Client of the list repository is shared and released by its Close method.
+ *
+ * lists: the ToDoListRepositoryDB whose client and settings are used
+ *
+ * returns: an instance of ShareLinkRepositoryDB
+ */
+
+func NewShareLinkRepositoryDB(lists ToDoListRepositoryDB) ShareLinkRepositoryDB {
+	return ShareLinkRepositoryDB{
+		lists:      lists,
+		collection: lists.client.Database(lists.settings.Name).Collection(lists.settings.ShareLinkCollection),
+	}
+}
@@ -7,43 +7,60 @@
 package repositories
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/luschnat-ziegler/toDoListAPI/config"
 	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
 	"github.com/luschnat-ziegler/toDoListAPI/errs"
 	"github.com/luschnat-ziegler/toDoListAPI/logger"
+	"github.com/luschnat-ziegler/toDoListAPI/tenancy"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
 )
 
-type ToDoListRepositoryDB struct{}
+type documentCursor interface {
+	Next(ctx context.Context) bool
+	Decode(val interface{}) error
+	Err() error
+	Close(ctx context.Context) error
+}
+
+type ToDoListRepositoryDB struct {
+	settings   config.Database
+	client     *mongo.Client
+	collection *mongo.Collection
+}
 
 /*
  * Method: ToDoListRepositoryDB.GetAll
  * --------------------
- * Retrieves all lists from the database.
+ * Retrieves all lists accessible to a subject (owned or shared, see accessFilter) from the database.
+ *
+ * ctx: the context.Context of the request
+ * subject: the subject of the requesting principal the lists are scoped to
  *
  * returns: a pointer to a slice of domain.ToDoList and nil on success.
  *          Otherwise, nil and a pointer to an errs.AppError are returned.
  */
 
-func (toDoListRepositoryDB ToDoListRepositoryDB) GetAll() (*[]domain.ToDoList, *errs.AppError) {
-	if err := connectDbClient(); err != nil {
-		logger.Error("Error connecting to database: " + err.Error())
-		return nil, errs.NewInternalError("Database Error")
-	}
-	defer disconnectClient(client, ctx)
+func (toDoListRepositoryDB ToDoListRepositoryDB) GetAll(ctx context.Context, subject string) (*[]domain.ToDoList, *errs.AppError) {
+	ctx, cancel := toDoListRepositoryDB.newContext(ctx)
 	defer cancel()
 
-	cursor, err := collection.Find(ctx, bson.D{})
+	cursor, err := toDoListRepositoryDB.collectionFor(ctx).Find(ctx, accessFilter(ctx, subject))
 	if err != nil {
-		logger.Error("Error querying database")
-		return nil, errs.NewInternalError("Database Error: " + err.Error())
+		logger.FromContext(ctx).Error("Error querying database: " + err.Error())
+		return nil, errs.NewInternalError("Database Error")
 	}
 
 	defer func() {
 		err = cursor.Close(ctx)
 		if err != nil {
-			logger.Error("Error closing cursor: " + err.Error())
+			logger.FromContext(ctx).Error("Error closing cursor: " + err.Error())
 		}
 	}()
 
@@ -53,7 +70,7 @@ func (toDoListRepositoryDB ToDoListRepositoryDB) GetAll() (*[]domain.ToDoList, *
 		var toDoList domain.ToDoList
 		err := cursor.Decode(&toDoList)
 		if err != nil {
-			logger.Error("Error decoding database object: " + err.Error())
+			logger.FromContext(ctx).Error("Error decoding database object: " + err.Error())
 			return nil, errs.NewInternalError("Database Error")
 		}
 		output = append(output, toDoList)
@@ -65,34 +82,30 @@ func (toDoListRepositoryDB ToDoListRepositoryDB) GetAll() (*[]domain.ToDoList, *
 /*
  * Method: ToDoListRepositoryDB.GetOneById
  * --------------------
- * Retrieves one list from the database (by id).
+ * Retrieves one list accessible to a subject from the database (by id). Inaccessible lists are reported as
+ * not found.
  *
- * id: a string representation of a primitive.ObjectID associated with the requested list
+ * ctx: the context.Context of the request
+ * subject: the subject of the requesting principal the list is scoped to (see accessFilter)
+ * id: the domain.ListId of the requested list
  *
  * returns: a pointer to a domain.ToDoList and nil on success.
  *          Otherwise, nil and a pointer to an errs.AppError are returned.
  */
 
-func (toDoListRepositoryDB ToDoListRepositoryDB) GetOneById(id string) (*domain.ToDoList, *errs.AppError) {
-	if err := connectDbClient(); err != nil {
-		logger.Error("Error connecting to database: " + err.Error())
-		return nil, errs.NewInternalError("Database Error")
-	}
-	defer disconnectClient(client, ctx)
+func (toDoListRepositoryDB ToDoListRepositoryDB) GetOneById(ctx context.Context, subject string, id domain.ListId) (*domain.ToDoList, *errs.AppError) {
+	ctx, cancel := toDoListRepositoryDB.newContext(ctx)
 	defer cancel()
 
-	objectId, err := primitive.ObjectIDFromHex(id)
-	if err != nil {
-		logger.Error("Error parsing id: " + err.Error())
-		return nil, errs.NewBadRequestError("ID is invalid")
-	}
+	filter := accessFilter(ctx, subject)
+	filter["_id"] = id.ObjectID()
 
 	var toDoList domain.ToDoList
 
-	err = collection.FindOne(ctx, bson.M{"_id": objectId}).Decode(&toDoList)
+	err := toDoListRepositoryDB.collectionFor(ctx).FindOne(ctx, filter).Decode(&toDoList)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return nil, errs.NewNotFoundError("No documents matching id " + id)
+			return nil, errs.Localized(errs.NewNotFoundError, errs.MessageListNotFound, "id", id.String())
 		} else {
 			return nil, errs.NewInternalError("Database error")
 		}
@@ -101,32 +114,60 @@ func (toDoListRepositoryDB ToDoListRepositoryDB) GetOneById(id string) (*domain.
 }
 
 /*
- * Method: ToDoListRepositoryDB.UpdateOneById
+ * Method: ToDoListRepositoryDB.GetOneSharedById
  * --------------------
- * Overwrites one list in the database (by id). Does not implement upserting.
+ * Retrieves one list of the workspace of the request from the database (by id), regardless of its owner and
+ * collaborators. To be used for lists shared by link only.
  *
- * id: a string representation of a primitive.ObjectID associated with the list requested for update.
- * newList: the new domain.ToDoList to overwrite the existing resource with.
+ * ctx: the context.Context of the request
+ * id: the domain.ListId of the requested list
  *
  * returns: a pointer to a domain.ToDoList and nil on success.
  *          Otherwise, nil and a pointer to an errs.AppError are returned.
  */
 
-func (toDoListRepositoryDB ToDoListRepositoryDB) UpdateOneById(id string, newList domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
-	if err := connectDbClient(); err != nil {
-		logger.Error("Error connecting to database: " + err.Error())
-		return nil, errs.NewInternalError("Database Error")
-	}
-	defer disconnectClient(client, ctx)
+func (toDoListRepositoryDB ToDoListRepositoryDB) GetOneSharedById(ctx context.Context, id domain.ListId) (*domain.ToDoList, *errs.AppError) {
+	ctx, cancel := toDoListRepositoryDB.newContext(ctx)
 	defer cancel()
 
-	objectId, err := primitive.ObjectIDFromHex(id)
+	filter := workspaceFilter(ctx)
+	filter["_id"] = id.ObjectID()
+
+	var toDoList domain.ToDoList
+
+	err := toDoListRepositoryDB.collectionFor(ctx).FindOne(ctx, filter).Decode(&toDoList)
 	if err != nil {
-		logger.Error("Error parsing id: " + err.Error())
-		return nil, errs.NewInternalError("Database Error")
+		if err == mongo.ErrNoDocuments {
+			return nil, errs.Localized(errs.NewNotFoundError, errs.MessageListNotFound, "id", id.String())
+		}
+		logger.FromContext(ctx).Error("Error querying database: " + err.Error())
+		return nil, errs.NewInternalError("Database error")
 	}
+	return &toDoList, nil
+}
 
-	filter := bson.M{"_id": objectId}
+/*
+ * Method: ToDoListRepositoryDB.UpdateOneById
+ * --------------------
+ * Overwrites one list accessible to a subject in the database (by id). Does not implement upserting. Owner and
+ * collaborators are never changed; inaccessible lists are reported as not found. Permissions of the subject are
+ * not checked (see DefaultToDoListService).
+ *
+ * ctx: the context.Context of the request
+ * subject: the subject of the requesting principal the list is scoped to (see accessFilter)
+ * id: the domain.ListId of the list requested for update.
+ * newList: the new domain.ToDoList to overwrite the existing resource with.
+ *
+ * returns: a pointer to a domain.ToDoList and nil on success.
+ *          Otherwise, nil and a pointer to an errs.AppError are returned.
+ */
+
+func (toDoListRepositoryDB ToDoListRepositoryDB) UpdateOneById(ctx context.Context, subject string, id domain.ListId, newList domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
+	ctx, cancel := toDoListRepositoryDB.newContext(ctx)
+	defer cancel()
+
+	filter := accessFilter(ctx, subject)
+	filter["_id"] = id.ObjectID()
 	update := bson.M{
 		"$set": bson.M{
 			"name":        newList.Name,
@@ -135,94 +176,419 @@ func (toDoListRepositoryDB ToDoListRepositoryDB) UpdateOneById(id string, newLis
 		},
 	}
 
-	res, err := collection.UpdateOne(ctx, filter, update)
-	if err != nil {
-		logger.Error("Error querying database: " + err.Error())
-		return nil, errs.NewInternalError("Database Error")
-	}
-
-	if res.MatchedCount == 0 {
-		return nil, errs.NewNotFoundError("No documents matching id " + id)
-	}
-
-	newList.Id = objectId
-	return &newList, nil
+	return toDoListRepositoryDB.findOneAndUpdate(ctx, id, filter, update)
 }
 
 /*
  * Method: ToDoListRepositoryDB.Save
  * --------------------
- * Saves one new list in the database.
+ * Saves one new list in the database, in the workspace of the request (see tenancy.WorkspaceFromContext).
  *
+ * ctx: the context.Context of the request
  * newList: the new domain.ToDoList to be persisted.
  *
  * returns: a pointer to a domain.ToDoList (the new resource) and nil on success.
  *          Otherwise, nil and a pointer to an errs.AppError are returned.
  */
 
-func (toDoListRepositoryDB ToDoListRepositoryDB) Save(newList domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
-	if err := connectDbClient(); err != nil {
-		logger.Error("Error connecting to database: " + err.Error())
-		return nil, errs.NewInternalError("Database Error")
-	}
-	defer disconnectClient(client, ctx)
+func (toDoListRepositoryDB ToDoListRepositoryDB) Save(ctx context.Context, newList domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
+	ctx, cancel := toDoListRepositoryDB.newContext(ctx)
 	defer cancel()
 
-	result, err := collection.InsertOne(ctx, newList)
+	newList.WorkspaceId = tenancy.WorkspaceFromContext(ctx)
+	result, err := toDoListRepositoryDB.collectionFor(ctx).InsertOne(ctx, newList)
 	if err != nil {
-		logger.Error("Error querying database: " + err.Error())
+		logger.FromContext(ctx).Error("Error querying database: " + err.Error())
 		return nil, errs.NewInternalError("Database error")
 	}
 
-	newList.Id = result.InsertedID.(primitive.ObjectID)
+	id, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		logger.FromContext(ctx).Error(fmt.Sprintf("Unexpected type of inserted id: %T", result.InsertedID))
+		return nil, errs.NewInternalError("Database error")
+	}
+	newList.Id = id
 	return &newList, nil
 }
 
 /*
  * Method: ToDoListRepositoryDB.DeleteOnById
  * --------------------
- * Deletes one list from the database.
+ * Deletes one list accessible to a subject from the database. Inaccessible lists are reported as not found.
+ * Permissions of the subject are not checked (see DefaultToDoListService).
  *
- * id: a string representation of a primitive.ObjectID associated with the list requested for deletion.
+ * ctx: the context.Context of the request
+ * subject: the subject of the requesting principal the list is scoped to (see accessFilter)
+ * id: the domain.ListId of the list requested for deletion.
  *
  * returns: nil on success or a pointer to an errs.AppError on failure
  */
 
-func (toDoListRepositoryDB ToDoListRepositoryDB) DeleteOneById(id string) *errs.AppError {
-	if err := connectDbClient(); err != nil {
-		logger.Error("Error connecting to database: " + err.Error())
-		return errs.NewInternalError("Database Error")
+func (toDoListRepositoryDB ToDoListRepositoryDB) DeleteOneById(ctx context.Context, subject string, id domain.ListId) *errs.AppError {
+	ctx, cancel := toDoListRepositoryDB.newContext(ctx)
+	defer cancel()
+
+	filter := accessFilter(ctx, subject)
+	filter["_id"] = id.ObjectID()
+
+	result, err := toDoListRepositoryDB.collectionFor(ctx).DeleteOne(ctx, filter)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error querying database: " + err.Error())
+		return errs.NewInternalError("Database error")
 	}
-	defer disconnectClient(client, ctx)
+
+	if result.DeletedCount == 0 {
+		return errs.Localized(errs.NewNotFoundError, errs.MessageListNotFound, "id", id.String())
+	}
+
+	return nil
+}
+
+/*
+ * Method: ToDoListRepositoryDB.UpdateCollaborators
+ * --------------------
+ * Replaces the collaborators of one list accessible to a subject in the database (by id). Inaccessible lists are
+ * reported as not found. Permissions of the subject are not checked (see DefaultToDoListService).
+ *
+ * ctx: the context.Context of the request
+ * subject: the subject of the requesting principal the list is scoped to (see accessFilter)
+ * id: the domain.ListId of the list requested for update.
+ * collaborators: the new collaborators of the list.
+ *
+ * returns: a pointer to the updated domain.ToDoList and nil on success.
+ *          Otherwise, nil and a pointer to an errs.AppError are returned.
+ */
+
+func (toDoListRepositoryDB ToDoListRepositoryDB) UpdateCollaborators(ctx context.Context, subject string, id domain.ListId, collaborators []domain.Collaborator) (*domain.ToDoList, *errs.AppError) {
+	ctx, cancel := toDoListRepositoryDB.newContext(ctx)
 	defer cancel()
 
-	objectId, err := primitive.ObjectIDFromHex(id)
+	filter := accessFilter(ctx, subject)
+	filter["_id"] = id.ObjectID()
+	update := bson.M{"$set": bson.M{"collaborators": collaborators}}
+
+	return toDoListRepositoryDB.findOneAndUpdate(ctx, id, filter, update)
+}
+
+/*
+ * Method: ToDoListRepositoryDB.findOneAndUpdate
+ * --------------------
+ * Applies an update to the list matching the filter and decodes the list as it is after the update.
+ *
+ * ctx: the context.Context of the request
+ * id: the id of the list, used for error messages
+ * filter: the filter matching the list
+ * update: the update document
+ *
+ * returns: a pointer to the updated domain.ToDoList and nil on success.
+ *          Otherwise, nil and a pointer to an errs.AppError are returned.
+ */
+
+func (toDoListRepositoryDB ToDoListRepositoryDB) findOneAndUpdate(ctx context.Context, id domain.ListId, filter bson.M, update bson.M) (*domain.ToDoList, *errs.AppError) {
+	var toDoList domain.ToDoList
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err := toDoListRepositoryDB.collectionFor(ctx).FindOneAndUpdate(ctx, filter, update, opts).Decode(&toDoList)
 	if err != nil {
-		logger.Error("Error parsing id: " + err.Error())
-		return errs.NewInternalError("Database Error")
+		if err == mongo.ErrNoDocuments {
+			return nil, errs.Localized(errs.NewNotFoundError, errs.MessageListNotFound, "id", id.String())
+		}
+		logger.FromContext(ctx).Error("Error querying database: " + err.Error())
+		return nil, errs.NewInternalError("Database Error")
 	}
 
-	result, err := collection.DeleteOne(ctx, bson.M{"_id": objectId})
+	return &toDoList, nil
+}
+
+/*
+ * Method: ToDoListRepositoryDB.ForEach
+ * --------------------
+ * Retrieves all lists of the workspace of the request (see tenancy.WorkspaceFromContext) from the database one by
+ * one and passes each to the provided function, without loading the whole collection into memory. Iteration stops
+ * at the first error returned by fn. The configured timeout applies to every round trip to the database rather than
+ * to the whole iteration, so that the time spent in fn does not count against it (see forEachDocument).
+ *
+ * ctx: the context.Context of the request
+ * fn: a function to be called with every list.
+ *
+ * returns: nil on success or a pointer to an errs.AppError on failure (including errors returned by fn).
+ */
+
+func (toDoListRepositoryDB ToDoListRepositoryDB) ForEach(ctx context.Context, fn func(domain.ToDoList) error) *errs.AppError {
+	findCtx, cancel := toDoListRepositoryDB.newContext(ctx)
+	defer cancel()
+
+	cursor, err := toDoListRepositoryDB.collectionFor(ctx).Find(findCtx, workspaceFilter(ctx))
 	if err != nil {
-		logger.Error("Error querying database: " + err.Error())
-		return errs.NewInternalError("Database error")
+		logger.FromContext(ctx).Error("Error querying database: " + err.Error())
+		return errs.NewInternalError("Database Error")
 	}
 
-	if result.DeletedCount == 0 {
-		return errs.NewNotFoundError("No Documents matching ID " + id)
+	return toDoListRepositoryDB.forEachDocument(ctx, cursor, fn)
+}
+
+/*
+ * Method: ToDoListRepositoryDB.forEachDocument
+ * --------------------
+ * Decodes every document of a cursor into a domain.ToDoList and passes it to the provided function. Every call to
+ * the cursor gets its own context with the configured timeout (see newContext), derived from the context of the
+ * request, so that iterations of any length are only bounded by the latter. Closes the cursor when done.
+ *
+ * ctx: the context.Context of the request
+ * cursor: the documentCursor to iterate, e.g. a *mongo.Cursor
+ * fn: a function to be called with every list.
+ *
+ * returns: nil on success or a pointer to an errs.AppError on failure (including errors returned by fn).
+ */
+
+func (toDoListRepositoryDB ToDoListRepositoryDB) forEachDocument(ctx context.Context, cursor documentCursor, fn func(domain.ToDoList) error) *errs.AppError {
+	defer func() {
+		closeCtx, cancel := toDoListRepositoryDB.newContext(ctx)
+		defer cancel()
+		if err := cursor.Close(closeCtx); err != nil {
+			logger.FromContext(ctx).Error("Error closing cursor: " + err.Error())
+		}
+	}()
+
+	for toDoListRepositoryDB.next(ctx, cursor) {
+		var toDoList domain.ToDoList
+		if err := cursor.Decode(&toDoList); err != nil {
+			logger.FromContext(ctx).Error("Error decoding database object: " + err.Error())
+			return errs.NewInternalError("Database Error")
+		}
+		if err := fn(toDoList); err != nil {
+			logger.FromContext(ctx).Error("Error processing list " + toDoList.Id.Hex() + ": " + err.Error())
+			return errs.NewInternalError("Error processing list " + toDoList.Id.Hex())
+		}
+	}
+
+	if err := cursor.Err(); err != nil {
+		logger.FromContext(ctx).Error("Error iterating cursor: " + err.Error())
+		return errs.NewInternalError("Database Error")
 	}
 
 	return nil
 }
 
+/*
+ * Method: ToDoListRepositoryDB.next
+ * --------------------
+ * Advances a cursor within the configured timeout (see newContext).
+ *
+ * ctx: the context.Context of the request
+ * cursor: the documentCursor to advance
+ *
+ * returns: true if the cursor holds another document, false otherwise (see documentCursor.Err)
+ */
+
+func (toDoListRepositoryDB ToDoListRepositoryDB) next(ctx context.Context, cursor documentCursor) bool {
+	ctx, cancel := toDoListRepositoryDB.newContext(ctx)
+	defer cancel()
+	return cursor.Next(ctx)
+}
+
+/*
+ * Method: ToDoListRepositoryDB.Restore
+ * --------------------
+ * Saves a list with its original id in the workspace of the request (see tenancy.WorkspaceFromContext). If a list
+ * with the same id already exists in the workspace, it is replaced if overwrite is true and left untouched
+ * otherwise. Lists of other workspaces are never replaced.
+ *
+ * ctx: the context.Context of the request
+ * list: the domain.ToDoList to be restored, including its id.
+ * overwrite: whether an existing list with the same id is to be replaced.
+ *
+ * returns: true if a list with the same id already existed, false otherwise, and nil on success.
+ *          Otherwise, false and a pointer to an errs.AppError are returned.
+ */
+
+func (toDoListRepositoryDB ToDoListRepositoryDB) Restore(ctx context.Context, list domain.ToDoList, overwrite bool) (bool, *errs.AppError) {
+	ctx, cancel := toDoListRepositoryDB.newContext(ctx)
+	defer cancel()
+
+	list.WorkspaceId = tenancy.WorkspaceFromContext(ctx)
+
+	if overwrite {
+		filter := workspaceFilter(ctx)
+		filter["_id"] = list.Id
+		result, err := toDoListRepositoryDB.collectionFor(ctx).ReplaceOne(ctx, filter, list, options.Replace().SetUpsert(true))
+		if isDuplicateKeyError(err) {
+			return false, errs.Localized(errs.NewConflictError, errs.MessageListInOtherWorkspace, "id", list.Id.Hex())
+		}
+		if err != nil {
+			logger.FromContext(ctx).Error("Error querying database: " + err.Error())
+			return false, errs.NewInternalError("Database Error")
+		}
+		return result.MatchedCount > 0, nil
+	}
+
+	if _, err := toDoListRepositoryDB.collectionFor(ctx).InsertOne(ctx, list); err != nil {
+		if isDuplicateKeyError(err) {
+			return true, nil
+		}
+		logger.FromContext(ctx).Error("Error querying database: " + err.Error())
+		return false, errs.NewInternalError("Database Error")
+	}
+	return false, nil
+}
+
+/*
+ * Method: ToDoListRepositoryDB.Ping
+ * --------------------
+ * Checks whether the database is reachable by sending a ping to the primary.
+ *
+ * ctx: the context.Context of the request
+ *
+ * returns: nil if the database answered, a pointer to an errs.AppError otherwise.
+ */
+
+func (toDoListRepositoryDB ToDoListRepositoryDB) Ping(ctx context.Context) *errs.AppError {
+	ctx, cancel := toDoListRepositoryDB.newContext(ctx)
+	defer cancel()
+
+	if err := toDoListRepositoryDB.client.Ping(ctx, readpref.Primary()); err != nil {
+		logger.FromContext(ctx).Error("Error pinging database: " + err.Error())
+		return errs.NewInternalError("Database unreachable")
+	}
+	return nil
+}
+
+/*
+ * Function: accessFilter
+ * --------------------
+ * Builds the query filter scoping lists to those of the workspace of the request (see workspaceFilter) which are
+ * accessible to a subject: lists owned by the subject and lists shared with the subject as collaborator. The empty
+ * subject (authentication disabled) matches lists without an owner only.
+ *
+ * ctx: the context.Context of the request
+ * subject: the subject of the requesting principal
+ *
+ * returns: a bson.M filter which may be extended with further conditions
+ */
+
+func accessFilter(ctx context.Context, subject string) bson.M {
+	filter := workspaceFilter(ctx)
+	if subject == "" {
+		filter["ownerId"] = bson.M{"$exists": false}
+	} else {
+		filter["$or"] = bson.A{
+			bson.M{"ownerId": subject},
+			bson.M{"collaborators.subject": subject},
+		}
+	}
+	return filter
+}
+
+/*
+ * Function: workspaceFilter
+ * --------------------
+ * Builds the query filter scoping lists to the workspace of the request (see tenancy.WorkspaceFromContext). The
+ * default workspace matches lists without a workspace only.
+ *
+ * ctx: the context.Context of the request
+ *
+ * returns: a bson.M filter which may be extended with further conditions
+ */
+
+func workspaceFilter(ctx context.Context) bson.M {
+	if workspaceId := tenancy.WorkspaceFromContext(ctx); workspaceId != "" {
+		return bson.M{"workspaceId": workspaceId}
+	}
+	return bson.M{"workspaceId": bson.M{"$exists": false}}
+}
+
+/*
+ * Function: isDuplicateKeyError
+ * --------------------
+ * Checks whether a write failed because of a duplicate key (server error code 11000).
+ *
+ * err: the error returned by a write operation.
+ *
+ * returns: true if err is a duplicate key error, false otherwise.
+ */
+
+func isDuplicateKeyError(err error) bool {
+	var writeException mongo.WriteException
+	if errors.As(err, &writeException) {
+		for _, writeError := range writeException.WriteErrors {
+			if writeError.Code == 11000 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 /*
  * Function: NewToDoListRepositoryDB
  * --------------------
- * Instantiates a new ToDoListRepositoryDB for dependency injection.
+ * Instantiates a new ToDoListRepositoryDB for dependency injection. The mongo.Client created here is shared by
+ * all operations and has to be released using Close.
+ *
+ * settings: the config.Database to connect with
+ *
+ * returns: an instance of ToDoListRepositoryDB and nil, or the zero value and an error if the client cannot be
+ *          created (e.g. because of an invalid url)
+ */
+
+func NewToDoListRepositoryDB(settings config.Database) (ToDoListRepositoryDB, error) {
+	client, err := connectDbClient(settings)
+	if err != nil {
+		return ToDoListRepositoryDB{}, err
+	}
+	return ToDoListRepositoryDB{
+		settings:   settings,
+		client:     client,
+		collection: client.Database(settings.Name).Collection(settings.Collection),
+	}, nil
+}
+
+/*
+ * Method: ToDoListRepositoryDB.collectionFor
+ * --------------------
+ * Selects the collection holding the lists of the workspace of the request. If databases per workspace are
+ * configured (see config.Database.PerWorkspace), the lists of a workspace are held in the database named after
+ * the configured database and the workspace id; the default workspace always uses the configured database.
+ *
+ * ctx: the context.Context of the request
+ *
+ * returns: a pointer to the mongo.Collection
+ */
+
+func (toDoListRepositoryDB ToDoListRepositoryDB) collectionFor(ctx context.Context) *mongo.Collection {
+	workspaceId := tenancy.WorkspaceFromContext(ctx)
+	if !toDoListRepositoryDB.settings.PerWorkspace || workspaceId == "" {
+		return toDoListRepositoryDB.collection
+	}
+	return toDoListRepositoryDB.client.Database(toDoListRepositoryDB.settings.Name + "_" + workspaceId).
+		Collection(toDoListRepositoryDB.settings.Collection)
+}
+
+/*
+ * Method: ToDoListRepositoryDB.Close
+ * --------------------
+ * Disconnects the shared mongo.Client. The repository must not be used afterwards.
+ *
+ * ctx: a context.Context limiting the time to wait for in-use connections
+ *
+ * returns: an error or nil
+ */
+
+func (toDoListRepositoryDB ToDoListRepositoryDB) Close(ctx context.Context) error {
+	return disconnectClient(toDoListRepositoryDB.client, ctx)
+}
+
+/*
+ * Method: ToDoListRepositoryDB.newContext
+ * --------------------
+ * Derives a context.Context with the configured timeout for a single database operation. The operation is
+ * cancelled as well if the parent context is, e.g. because the client went away.
+ *
+ * parent: the context.Context of the request
  *
- * returns: an instance of ToDoListRepositoryDB
+ * returns: the context.Context and the respective context.CancelFunc
  */
 
-func NewToDoListRepositoryDB() ToDoListRepositoryDB {
-	return ToDoListRepositoryDB{}
+func (toDoListRepositoryDB ToDoListRepositoryDB) newContext(parent context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(parent, toDoListRepositoryDB.settings.Timeout.Duration)
 }
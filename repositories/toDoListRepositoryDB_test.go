@@ -0,0 +1,139 @@
+/*
+ * package: repositories
+ * --------------------
+ * Includes repository implementation(s) (as defined in package ports)
+ */
+
+package repositories
+
+import (
+	"context"
+	"github.com/luschnat-ziegler/toDoListAPI/config"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/logger"
+	"github.com/luschnat-ziegler/toDoListAPI/testUtils/dummies"
+	"go.uber.org/zap/zapcore"
+	"testing"
+	"time"
+)
+
+type fakeCursor struct {
+	lists  []domain.ToDoList
+	index  int
+	err    error
+	closed bool
+}
+
+/*
+ * method: fakeCursor.Next
+ * --------------------
+ * Advances the cursor unless ctx is done, which, like a *mongo.Cursor, ends the iteration with ctx.Err().
+ *
+ * returns: true if the cursor holds another list, false otherwise
+ */
+
+func (c *fakeCursor) Next(ctx context.Context) bool {
+	if err := ctx.Err(); err != nil {
+		c.err = err
+		return false
+	}
+	c.index++
+	return c.index <= len(c.lists)
+}
+
+/*
+ * method: fakeCursor.Decode
+ * --------------------
+ * Copies the current list into val, a pointer to a domain.ToDoList.
+ *
+ * returns: nil
+ */
+
+func (c *fakeCursor) Decode(val interface{}) error {
+	*val.(*domain.ToDoList) = c.lists[c.index-1]
+	return nil
+}
+
+/*
+ * method: fakeCursor.Err
+ * --------------------
+ * Reports the error the iteration ended with.
+ *
+ * returns: the error or nil
+ */
+
+func (c *fakeCursor) Err() error {
+	return c.err
+}
+
+/*
+ * method: fakeCursor.Close
+ * --------------------
+ * Marks the cursor as closed.
+ *
+ * returns: ctx.Err()
+ */
+
+func (c *fakeCursor) Close(ctx context.Context) error {
+	c.closed = true
+	return ctx.Err()
+}
+
+/*
+ * function: Test_ToDoListRepositoryDB_forEachDocument_should_not_apply_timeout_to_whole_iteration
+ * --------------------
+ * Tests if a callback taking longer in total than the configured database timeout does not cut the iteration short,
+ * as the timeout applies to every call to the cursor separately.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListRepositoryDB_forEachDocument_should_not_apply_timeout_to_whole_iteration(t *testing.T) {
+	repository := ToDoListRepositoryDB{settings: config.Database{Timeout: config.Duration{Duration: 20 * time.Millisecond}}}
+	cursor := &fakeCursor{lists: []domain.ToDoList{dummies.DummyListValidWithIds, dummies.DummyListValidWithIds, dummies.DummyListValidWithIds}}
+
+	processed := 0
+	appErr := repository.forEachDocument(context.Background(), cursor, func(domain.ToDoList) error {
+		time.Sleep(15 * time.Millisecond)
+		processed++
+		return nil
+	})
+
+	if appErr != nil || processed != len(cursor.lists) {
+		t.Errorf("Expected all %d lists to be processed, got %d and %v instead", len(cursor.lists), processed, appErr)
+	}
+	if !cursor.closed {
+		t.Errorf("Expected cursor to be closed")
+	}
+}
+
+/*
+ * function: Test_ToDoListRepositoryDB_forEachDocument_should_stop_when_request_is_cancelled
+ * --------------------
+ * Tests if the iteration still stops with an error once the context of the request is cancelled.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_ToDoListRepositoryDB_forEachDocument_should_stop_when_request_is_cancelled(t *testing.T) {
+	defer logger.ReplaceCore(zapcore.NewNopCore())()
+
+	repository := ToDoListRepositoryDB{settings: config.Database{Timeout: config.Duration{Duration: time.Second}}}
+	cursor := &fakeCursor{lists: []domain.ToDoList{dummies.DummyListValidWithIds, dummies.DummyListValidWithIds}}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	processed := 0
+	appErr := repository.forEachDocument(ctx, cursor, func(domain.ToDoList) error {
+		processed++
+		cancel()
+		return nil
+	})
+
+	if appErr == nil || processed != 1 {
+		t.Errorf("Expected an error after one list, got %d lists and %v instead", processed, appErr)
+	}
+}
@@ -0,0 +1,165 @@
+/*
+ * package: repositories
+ * --------------------
+ * Includes repository implementation(s) (as defined in package ports)
+ */
+
+package repositories
+
+import (
+	"context"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/core/ports"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type TracedToDoListRepository struct {
+	repository ports.ToDoListRepository
+}
+
+/*
+ * Function: NewTracedToDoListRepository
+ * --------------------
+ * Decorates a repository to trace every operation in a span named "ToDoListRepository.<method>" (see
+ * tracing.Start).
+ *
+ * repository: the ports.ToDoListRepository to be decorated
+ *
+ * returns: a TracedToDoListRepository
+ */
+
+func NewTracedToDoListRepository(repository ports.ToDoListRepository) TracedToDoListRepository {
+	return TracedToDoListRepository{repository: repository}
+}
+
+/*
+ * Method: TracedToDoListRepository.GetAll
+ * --------------------
+ * See ports.ToDoListRepository.
+ */
+
+func (r TracedToDoListRepository) GetAll(ctx context.Context, subject string) (*[]domain.ToDoList, *errs.AppError) {
+	ctx, span := tracing.Start(ctx, "ToDoListRepository.GetAll")
+	toDoLists, appErr := r.repository.GetAll(ctx, subject)
+	tracing.End(span, appErr)
+	return toDoLists, appErr
+}
+
+/*
+ * Method: TracedToDoListRepository.GetOneById
+ * --------------------
+ * See ports.ToDoListRepository.
+ */
+
+func (r TracedToDoListRepository) GetOneById(ctx context.Context, subject string, id domain.ListId) (*domain.ToDoList, *errs.AppError) {
+	ctx, span := tracing.Start(ctx, "ToDoListRepository.GetOneById", attribute.String("todolist.id", id.String()))
+	toDoList, appErr := r.repository.GetOneById(ctx, subject, id)
+	tracing.End(span, appErr)
+	return toDoList, appErr
+}
+
+/*
+ * Method: TracedToDoListRepository.GetOneSharedById
+ * --------------------
+ * See ports.ToDoListRepository.
+ */
+
+func (r TracedToDoListRepository) GetOneSharedById(ctx context.Context, id domain.ListId) (*domain.ToDoList, *errs.AppError) {
+	ctx, span := tracing.Start(ctx, "ToDoListRepository.GetOneSharedById", attribute.String("todolist.id", id.String()))
+	toDoList, appErr := r.repository.GetOneSharedById(ctx, id)
+	tracing.End(span, appErr)
+	return toDoList, appErr
+}
+
+/*
+ * Method: TracedToDoListRepository.UpdateOneById
+ * --------------------
+ * See ports.ToDoListRepository.
+ */
+
+func (r TracedToDoListRepository) UpdateOneById(ctx context.Context, subject string, id domain.ListId, toDoList domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
+	ctx, span := tracing.Start(ctx, "ToDoListRepository.UpdateOneById", attribute.String("todolist.id", id.String()))
+	updated, appErr := r.repository.UpdateOneById(ctx, subject, id, toDoList)
+	tracing.End(span, appErr)
+	return updated, appErr
+}
+
+/*
+ * Method: TracedToDoListRepository.Save
+ * --------------------
+ * See ports.ToDoListRepository.
+ */
+
+func (r TracedToDoListRepository) Save(ctx context.Context, toDoList domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
+	ctx, span := tracing.Start(ctx, "ToDoListRepository.Save")
+	saved, appErr := r.repository.Save(ctx, toDoList)
+	tracing.End(span, appErr)
+	return saved, appErr
+}
+
+/*
+ * Method: TracedToDoListRepository.DeleteOneById
+ * --------------------
+ * See ports.ToDoListRepository.
+ */
+
+func (r TracedToDoListRepository) DeleteOneById(ctx context.Context, subject string, id domain.ListId) *errs.AppError {
+	ctx, span := tracing.Start(ctx, "ToDoListRepository.DeleteOneById", attribute.String("todolist.id", id.String()))
+	appErr := r.repository.DeleteOneById(ctx, subject, id)
+	tracing.End(span, appErr)
+	return appErr
+}
+
+/*
+ * Method: TracedToDoListRepository.UpdateCollaborators
+ * --------------------
+ * See ports.ToDoListRepository.
+ */
+
+func (r TracedToDoListRepository) UpdateCollaborators(ctx context.Context, subject string, id domain.ListId, collaborators []domain.Collaborator) (*domain.ToDoList, *errs.AppError) {
+	ctx, span := tracing.Start(ctx, "ToDoListRepository.UpdateCollaborators", attribute.String("todolist.id", id.String()))
+	updated, appErr := r.repository.UpdateCollaborators(ctx, subject, id, collaborators)
+	tracing.End(span, appErr)
+	return updated, appErr
+}
+
+/*
+ * Method: TracedToDoListRepository.ForEach
+ * --------------------
+ * See ports.ToDoListRepository. The span includes the time spent in fn.
+ */
+
+func (r TracedToDoListRepository) ForEach(ctx context.Context, fn func(domain.ToDoList) error) *errs.AppError {
+	ctx, span := tracing.Start(ctx, "ToDoListRepository.ForEach")
+	appErr := r.repository.ForEach(ctx, fn)
+	tracing.End(span, appErr)
+	return appErr
+}
+
+/*
+ * Method: TracedToDoListRepository.Restore
+ * --------------------
+ * See ports.ToDoListRepository.
+ */
+
+func (r TracedToDoListRepository) Restore(ctx context.Context, toDoList domain.ToDoList, overwrite bool) (bool, *errs.AppError) {
+	ctx, span := tracing.Start(ctx, "ToDoListRepository.Restore", attribute.String("todolist.id", toDoList.Id.Hex()))
+	replaced, appErr := r.repository.Restore(ctx, toDoList, overwrite)
+	tracing.End(span, appErr)
+	return replaced, appErr
+}
+
+/*
+ * Method: TracedToDoListRepository.Ping
+ * --------------------
+ * See ports.ToDoListRepository.
+ */
+
+func (r TracedToDoListRepository) Ping(ctx context.Context) *errs.AppError {
+	ctx, span := tracing.Start(ctx, "ToDoListRepository.Ping")
+	appErr := r.repository.Ping(ctx)
+	tracing.End(span, appErr)
+	return appErr
+}
@@ -0,0 +1,68 @@
+/*
+ * package: repositories
+ * --------------------
+ * Includes repository implementation(s) (as defined in package ports)
+ */
+
+package repositories
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/testUtils/dummies"
+	"github.com/luschnat-ziegler/toDoListAPI/testUtils/mocks/ports"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"testing"
+)
+
+/*
+ * function: Test_TracedToDoListRepository_should_trace_operations_as_children
+ * --------------------
+ * Tests if the decorator traces operations in spans named after the method, as children of the span in the given
+ * context, and does not mark spans as failed for client errors.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_TracedToDoListRepository_should_trace_operations_as_children(t *testing.T) {
+	spans := tracetest.NewSpanRecorder()
+	previousProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)))
+	defer otel.SetTracerProvider(previousProvider)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepository := ports.NewMockToDoListRepository(ctrl)
+	repository := NewTracedToDoListRepository(mockRepository)
+
+	dummyError := errs.NewNotFoundError("No documents matching id " + dummies.DummyListIdHex)
+	mockRepository.EXPECT().DeleteOneById(gomock.Any(), "test_owner", dummies.DummyListId).Return(dummyError).Times(1)
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+	appErr := repository.DeleteOneById(ctx, "test_owner", dummies.DummyListId)
+	parent.End()
+
+	if appErr != dummyError {
+		t.Errorf("Expected wrapped error, got %v instead", appErr)
+	}
+
+	ended := spans.Ended()
+	if len(ended) != 2 {
+		t.Fatalf("Expected 2 spans, got %v instead", len(ended))
+	}
+	if ended[0].Name() != "ToDoListRepository.DeleteOneById" {
+		t.Errorf("Unexpected span name %v", ended[0].Name())
+	}
+	if ended[0].Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Error("Expected span to be a child of the span in the context")
+	}
+	if ended[0].Status().Code == codes.Error {
+		t.Error("Expected client error not to mark span as failed")
+	}
+}
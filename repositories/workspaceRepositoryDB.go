@@ -0,0 +1,201 @@
+/*
+ * package: repositories
+ * --------------------
+ * Includes repository implementation(s) (as defined in package ports)
+ */
+
+package repositories
+
+import (
+	"context"
+	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"github.com/luschnat-ziegler/toDoListAPI/logger"
+	"github.com/luschnat-ziegler/toDoListAPI/tenancy"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type WorkspaceRepositoryDB struct {
+	lists      ToDoListRepositoryDB
+	collection *mongo.Collection
+}
+
+/*
+ * Method: WorkspaceRepositoryDB.GetAll
+ * --------------------
+ * Retrieves all workspaces a subject belongs to (as owner or member) from the database.
+ *
+ * ctx: the context.Context of the request
+ * subject: the subject of the requesting principal
+ *
+ * returns: a pointer to a slice of domain.Workspace and nil on success.
+ *          Otherwise, nil and a pointer to an errs.AppError are returned.
+ */
+
+func (workspaceRepositoryDB WorkspaceRepositoryDB) GetAll(ctx context.Context, subject string) (*[]domain.Workspace, *errs.AppError) {
+	ctx, cancel := workspaceRepositoryDB.lists.newContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"$or": bson.A{bson.M{"ownerId": subject}, bson.M{"members": subject}}}
+	if subject == "" {
+		filter = bson.M{"ownerId": bson.M{"$exists": false}}
+	}
+
+	cursor, err := workspaceRepositoryDB.collection.Find(ctx, filter)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error querying database: " + err.Error())
+		return nil, errs.NewInternalError("Database Error")
+	}
+
+	output := []domain.Workspace{}
+	if err := cursor.All(ctx, &output); err != nil {
+		logger.FromContext(ctx).Error("Error decoding database object: " + err.Error())
+		return nil, errs.NewInternalError("Database Error")
+	}
+
+	return &output, nil
+}
+
+/*
+ * Method: WorkspaceRepositoryDB.GetOneById
+ * --------------------
+ * Retrieves one workspace from the database (by id).
+ *
+ * ctx: the context.Context of the request
+ * id: the id of the requested workspace
+ *
+ * returns: a pointer to a domain.Workspace and nil on success.
+ *          Otherwise, nil and a pointer to an errs.AppError are returned.
+ */
+
+func (workspaceRepositoryDB WorkspaceRepositoryDB) GetOneById(ctx context.Context, id string) (*domain.Workspace, *errs.AppError) {
+	ctx, cancel := workspaceRepositoryDB.lists.newContext(ctx)
+	defer cancel()
+
+	var workspace domain.Workspace
+
+	err := workspaceRepositoryDB.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&workspace)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errs.Localized(errs.NewNotFoundError, errs.MessageWorkspaceNotFound, "id", id)
+		}
+		logger.FromContext(ctx).Error("Error querying database: " + err.Error())
+		return nil, errs.NewInternalError("Database error")
+	}
+	return &workspace, nil
+}
+
+/*
+ * Method: WorkspaceRepositoryDB.Save
+ * --------------------
+ * Saves one new workspace in the database. Workspace ids are chosen by the client and have to be unique.
+ *
+ * ctx: the context.Context of the request
+ * newWorkspace: the new domain.Workspace to be persisted.
+ *
+ * returns: a pointer to the domain.Workspace and nil on success.
+ *          Otherwise, nil and a pointer to an errs.AppError (code 409 if the id is taken) are returned.
+ */
+
+func (workspaceRepositoryDB WorkspaceRepositoryDB) Save(ctx context.Context, newWorkspace domain.Workspace) (*domain.Workspace, *errs.AppError) {
+	ctx, cancel := workspaceRepositoryDB.lists.newContext(ctx)
+	defer cancel()
+
+	if _, err := workspaceRepositoryDB.collection.InsertOne(ctx, newWorkspace); err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, errs.Localized(errs.NewConflictError, errs.MessageWorkspaceExists, "id", newWorkspace.Id)
+		}
+		logger.FromContext(ctx).Error("Error querying database: " + err.Error())
+		return nil, errs.NewInternalError("Database error")
+	}
+	return &newWorkspace, nil
+}
+
+/*
+ * Method: WorkspaceRepositoryDB.UpdateOneById
+ * --------------------
+ * Overwrites name and members of one workspace in the database (by id). Id and owner are never changed.
+ *
+ * ctx: the context.Context of the request
+ * id: the id of the workspace requested for update.
+ * newWorkspace: the domain.Workspace to overwrite the existing resource with.
+ *
+ * returns: a pointer to the updated domain.Workspace and nil on success.
+ *          Otherwise, nil and a pointer to an errs.AppError are returned.
+ */
+
+func (workspaceRepositoryDB WorkspaceRepositoryDB) UpdateOneById(ctx context.Context, id string, newWorkspace domain.Workspace) (*domain.Workspace, *errs.AppError) {
+	ctx, cancel := workspaceRepositoryDB.lists.newContext(ctx)
+	defer cancel()
+
+	update := bson.M{"$set": bson.M{"name": newWorkspace.Name, "members": newWorkspace.Members}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var workspace domain.Workspace
+
+	err := workspaceRepositoryDB.collection.FindOneAndUpdate(ctx, bson.M{"_id": id}, update, opts).Decode(&workspace)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errs.Localized(errs.NewNotFoundError, errs.MessageWorkspaceNotFound, "id", id)
+		}
+		logger.FromContext(ctx).Error("Error querying database: " + err.Error())
+		return nil, errs.NewInternalError("Database Error")
+	}
+	return &workspace, nil
+}
+
+/*
+ * Method: WorkspaceRepositoryDB.DeleteOneById
+ * --------------------
+ * Deletes one workspace and all of its lists from the database. The lists are deleted first, so that a workspace
+ * created later with the same id never inherits lists of the deleted one.
+ *
+ * ctx: the context.Context of the request
+ * id: the id of the workspace requested for deletion.
+ *
+ * returns: nil on success or a pointer to an errs.AppError on failure
+ */
+
+func (workspaceRepositoryDB WorkspaceRepositoryDB) DeleteOneById(ctx context.Context, id string) *errs.AppError {
+	ctx, cancel := workspaceRepositoryDB.lists.newContext(ctx)
+	defer cancel()
+
+	listsCtx := tenancy.WithWorkspace(ctx, id)
+	if _, err := workspaceRepositoryDB.lists.collectionFor(listsCtx).DeleteMany(ctx, workspaceFilter(listsCtx)); err != nil {
+		logger.FromContext(ctx).Error("Error deleting lists of workspace: " + err.Error())
+		return errs.NewInternalError("Database error")
+	}
+
+	result, err := workspaceRepositoryDB.collection.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		logger.FromContext(ctx).Error("Error querying database: " + err.Error())
+		return errs.NewInternalError("Database error")
+	}
+
+	if result.DeletedCount == 0 {
+		return errs.Localized(errs.NewNotFoundError, errs.MessageWorkspaceNotFound, "id", id)
+	}
+
+	return nil
+}
+
+/*
+ * Function: NewWorkspaceRepositoryDB
+ * --------------------
+ * Instantiates a new WorkspaceRepositoryDB for dependency injection. Workspaces are held in the configured
+ * workspace collection of the configured database. The mongo.Client of the list repository is shared and released
+ * by its Close method.
+ *
+ * lists: the ToDoListRepositoryDB whose client and settings are used
+ *
+ * returns: an instance of WorkspaceRepositoryDB
+ */
+
+func NewWorkspaceRepositoryDB(lists ToDoListRepositoryDB) WorkspaceRepositoryDB {
+	return WorkspaceRepositoryDB{
+		lists:      lists,
+		collection: lists.client.Database(lists.settings.Name).Collection(lists.settings.WorkspaceCollection),
+	}
+}
@@ -0,0 +1,111 @@
+/*
+ * package: server
+ * --------------------
+ * Includes server functionalities and application wiring
+ */
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/luschnat-ziegler/toDoListAPI/admin"
+	"github.com/luschnat-ziegler/toDoListAPI/config"
+	"github.com/luschnat-ziegler/toDoListAPI/repositories"
+	"github.com/luschnat-ziegler/toDoListAPI/tenancy"
+	"io"
+	"os"
+)
+
+const commandUsage = `Usage:
+  toDoListAPI                                             start the server
+  toDoListAPI backup [-workspace id] [file]               write all lists as newline-delimited JSON to file (default: stdout)
+  toDoListAPI restore [-workspace id] [-overwrite] [file] restore lists from a backup file (default: stdin)
+
+Both commands operate on one workspace, the default workspace unless -workspace is given.`
+
+/*
+ * function: RunCommand
+ * --------------------
+ * Runs an administrative command (backup or restore) against the configured repository instead of starting the
+ * server. Reports and errors are written to stderr, so that a backup can be written to stdout. Commands are scoped
+ * to one workspace (see tenancy.WithWorkspace).
+ *
+ * cfg: the validated config.Config
+ * args: the command line arguments following the program name.
+ *
+ * returns: the exit code; 0 on success, 1 on failure and 2 on invalid usage.
+ */
+
+func RunCommand(cfg config.Config, args []string) int {
+	if len(args) == 0 || (args[0] != "backup" && args[0] != "restore") {
+		fmt.Fprintln(os.Stderr, commandUsage)
+		return 2
+	}
+
+	flags := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	overwrite := flags.Bool("overwrite", false, "replace existing lists with the same id")
+	workspace := flags.String("workspace", "", "id of the workspace (default: the default workspace)")
+	if err := flags.Parse(args[1:]); err != nil || flags.NArg() > 1 || (args[0] == "backup" && *overwrite) {
+		fmt.Fprintln(os.Stderr, commandUsage)
+		return 2
+	}
+
+	repo, err := repositories.NewToDoListRepositoryDB(cfg.Database)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error connecting to database: "+err.Error())
+		return 1
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.Database.Timeout.Duration)
+		defer cancel()
+		_ = repo.Close(ctx)
+	}()
+
+	ctx := tenancy.WithWorkspace(context.Background(), *workspace)
+
+	if args[0] == "backup" {
+		var w io.Writer = os.Stdout
+		if flags.NArg() == 1 {
+			file, err := os.Create(flags.Arg(0))
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "Error creating backup file: "+err.Error())
+				return 1
+			}
+			defer file.Close()
+			w = file
+		}
+		count, appErr := admin.Backup(ctx, w, repo)
+		if appErr != nil {
+			fmt.Fprintf(os.Stderr, "Backup failed after %d lists: %s\n", count, appErr.Message)
+			return 1
+		}
+		fmt.Fprintf(os.Stderr, "Backed up %d lists\n", count)
+		return 0
+	}
+
+	var r io.Reader = os.Stdin
+	if flags.NArg() == 1 {
+		file, err := os.Open(flags.Arg(0))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Error opening backup file: "+err.Error())
+			return 1
+		}
+		defer file.Close()
+		r = file
+	}
+	report, appErr := admin.Restore(ctx, r, repo, *overwrite)
+	encoder := json.NewEncoder(os.Stderr)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(report)
+	if appErr != nil {
+		fmt.Fprintln(os.Stderr, "Restore failed: "+appErr.Message)
+		return 1
+	}
+	if len(report.Conflicts) > 0 || len(report.ParseErrors) > 0 || len(report.InvalidLists) > 0 {
+		return 1
+	}
+	return 0
+}
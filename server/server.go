@@ -7,65 +7,181 @@
 package server
 
 import (
-	"fmt"
-	"github.com/gorilla/mux"
+	"context"
+	"errors"
+	"github.com/luschnat-ziegler/toDoListAPI/auth"
+	"github.com/luschnat-ziegler/toDoListAPI/config"
 	"github.com/luschnat-ziegler/toDoListAPI/core/services"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
 	"github.com/luschnat-ziegler/toDoListAPI/handlers"
 	"github.com/luschnat-ziegler/toDoListAPI/logger"
+	"github.com/luschnat-ziegler/toDoListAPI/metrics"
+	"github.com/luschnat-ziegler/toDoListAPI/ratelimit"
 	"github.com/luschnat-ziegler/toDoListAPI/repositories"
+	"github.com/luschnat-ziegler/toDoListAPI/tracing"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 )
 
 /*
  * function: Start
  * --------------------
  * Sets up routing as well as repositories, services and handlers with
- * their dependencies. Starts the server listening for requests.
+ * their dependencies. If authentication is enabled, lists are only
+ * accessible to authenticated requests. Requests of trusted proxies are
+ * attributed to the clients they forward for (see handlers.ForwardedFor).
+ * Starts the server listening for requests on the configured address and
+ * blocks until it is shut down by SIGINT or SIGTERM (see serve). Flushes
+ * pending traces and closes the repository before returning.
+ *
+ * cfg: the validated config.Config
  *
  * returns: nothing
  */
 
-func Start() {
-	if sanityCheck() {
-		logger.Info("Application started...")
+func Start(cfg config.Config) {
+	logger.Info("Application started...")
 
-		toDoListRepositoryDB := repositories.NewToDoListRepositoryDB()
-		th := handlers.ToDoListHandlers{Service: services.NewToDoListService(toDoListRepositoryDB)}
+	shutdownTracing, err := tracing.Setup(cfg.Tracing)
+	if err != nil {
+		logger.Error("Error setting up tracing: " + err.Error())
+		return
+	}
+	authenticator, err := auth.NewAuthenticator(cfg.Auth)
+	if err != nil {
+		logger.Error("Error setting up authentication: " + err.Error())
+		return
+	}
+	toDoListRepositoryDB, err := repositories.NewToDoListRepositoryDB(cfg.Database)
+	if err != nil {
+		logger.Error("Error connecting to database: " + err.Error())
+		return
+	}
 
-		router := mux.NewRouter()
-		router.HandleFunc("/", handlers.GetInfo).Methods(http.MethodGet)
-		router.HandleFunc("/todos", th.GetAll).Methods(http.MethodGet)
-		router.HandleFunc("/todos", th.Save).Methods(http.MethodPost)
-		router.HandleFunc("/todos/{id}", th.GetOne).Methods(http.MethodGet)
-		router.HandleFunc("/todos/{id}", th.Update).Methods(http.MethodPut)
-		router.HandleFunc("/todos/{id}", th.Delete).Methods(http.MethodDelete)
+	toDoListRepository := repositories.NewTracedToDoListRepository(repositories.NewInstrumentedToDoListRepository(toDoListRepositoryDB))
+	toDoListService := services.NewTracedToDoListService(services.NewToDoListService(toDoListRepository))
+	th := handlers.ToDoListHandlers{Service: toDoListService}
+	workspaceService := services.NewWorkspaceService(repositories.NewWorkspaceRepositoryDB(toDoListRepositoryDB))
+	wh := handlers.WorkspaceHandlers{Service: workspaceService}
+	sh := handlers.ShareLinkHandlers{Service: services.NewShareLinkService(toDoListRepository, repositories.NewShareLinkRepositoryDB(toDoListRepositoryDB))}
+	hh := handlers.HealthHandlers{Checks: map[string]func(context.Context) *errs.AppError{
+		"database": toDoListRepository.Ping,
+	}}
 
-		if err := http.ListenAndServe(":8000", router); err != nil {
-			logger.Error("Error starting server: " + err.Error())
+	router := handlers.NewRouter()
+	router.Use(metrics.Route, tracing.Middleware, handlers.LimitBody(int64(cfg.Server.MaxBodyBytes)))
+	router.Handle("/metrics", metrics.Handler()).Methods(http.MethodGet)
+	router.HandleFunc("/", handlers.GetInfo).Methods(http.MethodGet)
+	router.HandleFunc("/healthz", hh.Live).Methods(http.MethodGet)
+	router.HandleFunc("/readyz", hh.Ready).Methods(http.MethodGet)
+
+	public := router.NewRoute().Subrouter()
+	public.HandleFunc("/shared/{token}", sh.GetShared).Methods(http.MethodGet)
+
+	api := router.NewRoute().Subrouter()
+	if cfg.Auth.Enabled {
+		if cfg.RateLimit.Enabled {
+			authLimiter := ratelimit.NewLimiter(cfg.RateLimit.Auth)
+			api.Use(handlers.RateLimit(authLimiter, authLimiter))
 		}
+		api.Use(handlers.Authenticate(authenticator))
+	} else {
+		logger.Warn("Authentication is disabled, all lists are accessible without credentials")
+	}
+	if cfg.RateLimit.Enabled {
+		publicLimiter := ratelimit.NewLimiter(cfg.RateLimit.Public)
+		public.Use(handlers.RateLimit(publicLimiter, publicLimiter))
+		api.Use(handlers.RateLimit(ratelimit.NewLimiter(cfg.RateLimit.Read), ratelimit.NewLimiter(cfg.RateLimit.Write)))
+	}
+	api.HandleFunc("/workspaces", wh.GetAll).Methods(http.MethodGet)
+	api.HandleFunc("/workspaces", wh.Save).Methods(http.MethodPost)
+	api.HandleFunc("/workspaces/{id}", wh.GetOne).Methods(http.MethodGet)
+	api.HandleFunc("/workspaces/{id}", wh.Update).Methods(http.MethodPut)
+	api.HandleFunc("/workspaces/{id}", wh.Delete).Methods(http.MethodDelete)
+
+	lists := api.NewRoute().Subrouter()
+	lists.Use(handlers.ResolveWorkspace(workspaceService))
+	handlers.RegisterListRoutes(lists, &th, &sh)
+
+	var handler http.Handler = router
+	if len(cfg.CORS.AllowedOrigins) > 0 {
+		handler = handlers.CORS(cfg.CORS)(router)
+	}
+	handler = logger.Middleware(metrics.Middleware(handlers.Recover()(handler)))
+	if trustedProxies, _ := cfg.Server.TrustedNetworks(); len(trustedProxies) > 0 {
+		handler = handlers.ForwardedFor(trustedProxies)(handler)
+	}
+
+	httpServer := &http.Server{
+		Addr:         cfg.Server.Address,
+		Handler:      handler,
+		ReadTimeout:  cfg.Server.ReadTimeout.Duration,
+		WriteTimeout: cfg.Server.WriteTimeout.Duration,
+		IdleTimeout:  cfg.Server.IdleTimeout.Duration,
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	if err := serve(httpServer, cfg.Server.ShutdownTimeout.Duration, signals); err != nil {
+		logger.Error("Error running server: " + err.Error())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout.Duration)
+	defer cancel()
+	if err := shutdownTracing(ctx); err != nil {
+		logger.Error("Error flushing traces: " + err.Error())
+	}
+	if err := toDoListRepositoryDB.Close(ctx); err != nil {
+		logger.Error("Error closing database connections: " + err.Error())
+	} else {
+		logger.Info("Database connections closed")
 	}
 }
 
 /*
- * function: sanityCheck
+ * function: serve
  * --------------------
- * Checks for existence of needed environment variables. In case of missing variable logs error indicating missing
- * variable
+ * Runs the http.Server until it fails or a signal is received. On a signal, the server stops accepting new
+ * connections and waits for in-flight requests to complete, at most for shutdownTimeout, before remaining
+ * connections are closed forcibly.
+ *
+ * httpServer: a pointer to the http.Server to be run
+ * shutdownTimeout: the maximum time to wait for in-flight requests
+ * signals: a channel delivering the signals that trigger the shutdown
  *
- * returns: bool; true if check passes, false otherwise.
+ * returns: nil after a graceful shutdown, otherwise an error
  */
 
-func sanityCheck() bool {
-	envVars := []string{
-		"DB_URL",
+func serve(httpServer *http.Server, shutdownTimeout time.Duration, signals <-chan os.Signal) error {
+	serverErrors := make(chan error, 1)
+	go func() {
+		serverErrors <- httpServer.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serverErrors:
+		return err
+	case sig := <-signals:
+		logger.Info("Received " + sig.String() + ", shutting down...")
 	}
-	for _, envVar := range envVars {
-		_, ok := os.LookupEnv(envVar)
-		if !ok {
-			logger.Error(fmt.Sprintf("Environment variable %s not set in .env. Terminating application...", envVar))
-			return false
-		}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := httpServer.Shutdown(ctx); err != nil {
+		_ = httpServer.Close()
+		return errors.New("graceful shutdown failed: " + err.Error())
 	}
-	return true
+
+	if err := <-serverErrors; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	logger.Info("Server stopped")
+	return nil
 }
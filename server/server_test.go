@@ -0,0 +1,116 @@
+/*
+ * package: server
+ * --------------------
+ * Includes server functionalities and application wiring
+ */
+
+package server
+
+import (
+	"net"
+	"net/http"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+/*
+ * function: freeAddress
+ * --------------------
+ * Finds a free local TCP address to listen on.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: the address as string
+ */
+
+func freeAddress(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	return listener.Addr().String()
+}
+
+/*
+ * function: Test_serve_should_complete_in_flight_requests_on_signal
+ * --------------------
+ * Tests if serve lets a request in progress complete after a shutdown signal and returns nil afterwards.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_serve_should_complete_in_flight_requests_on_signal(t *testing.T) {
+	address := freeAddress(t)
+	requestStarted := make(chan struct{})
+	httpServer := &http.Server{
+		Addr: address,
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			close(requestStarted)
+			time.Sleep(200 * time.Millisecond)
+			w.WriteHeader(http.StatusOK)
+		}),
+	}
+
+	signals := make(chan os.Signal, 1)
+	serveResult := make(chan error, 1)
+	go func() {
+		serveResult <- serve(httpServer, 5*time.Second, signals)
+	}()
+
+	responseCode := make(chan int, 1)
+	go func() {
+		for i := 0; i < 50; i++ {
+			response, err := http.Get("http://" + address)
+			if err == nil {
+				responseCode <- response.StatusCode
+				_ = response.Body.Close()
+				return
+			}
+			time.Sleep(20 * time.Millisecond)
+		}
+		responseCode <- 0
+	}()
+
+	select {
+	case <-requestStarted:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Request did not reach the server")
+	}
+	signals <- syscall.SIGTERM
+
+	if code := <-responseCode; code != http.StatusOK {
+		t.Errorf("Expected in-flight request to complete with code 200, got %v instead", code)
+	}
+	if err := <-serveResult; err != nil {
+		t.Errorf("Expected nil, got error %v instead", err)
+	}
+}
+
+/*
+ * function: Test_serve_should_return_error_if_address_unavailable
+ * --------------------
+ * Tests if serve returns the error of ListenAndServe without waiting for a signal.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_serve_should_return_error_if_address_unavailable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	httpServer := &http.Server{Addr: listener.Addr().String(), Handler: http.NotFoundHandler()}
+
+	if err := serve(httpServer, time.Second, make(chan os.Signal)); err == nil {
+		t.Error("Expected error, got nil instead")
+	}
+}
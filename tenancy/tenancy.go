@@ -0,0 +1,43 @@
+/*
+ * package: tenancy
+ * --------------------
+ * Includes the workspace (tenant) a request is scoped to.
+ */
+
+package tenancy
+
+import "context"
+
+const Header = "X-Workspace-ID"
+
+type workspaceKey struct{}
+
+/*
+ * Function: WithWorkspace
+ * --------------------
+ * Stores the id of the workspace a request is scoped to in a context. The empty id denotes the default workspace.
+ *
+ * ctx: the parent context.Context
+ * workspaceId: the id of the workspace
+ *
+ * returns: a context.Context carrying the workspace id
+ */
+
+func WithWorkspace(ctx context.Context, workspaceId string) context.Context {
+	return context.WithValue(ctx, workspaceKey{}, workspaceId)
+}
+
+/*
+ * Function: WorkspaceFromContext
+ * --------------------
+ * Retrieves the id of the workspace stored by WithWorkspace.
+ *
+ * ctx: the context.Context of the request
+ *
+ * returns: the workspace id, or the empty id (default workspace) if none is stored
+ */
+
+func WorkspaceFromContext(ctx context.Context) string {
+	workspaceId, _ := ctx.Value(workspaceKey{}).(string)
+	return workspaceId
+}
@@ -0,0 +1,13 @@
+/*
+ * package: dummies
+ * --------------------
+ * Provides dummy data for unit testing
+ */
+
+package dummies
+
+var DummyListValidWithIdsAsCSV = "list_id,list_name,list_description,task_id,task_name,task_description,task_done,task_due\n601be448b9b5e15374b1e842,Dummy List Name,,1234,Dummy Task 1,,false,\n601be448b9b5e15374b1e842,Dummy List Name,,3245,Dummy Task 2,,false,\n"
+var DummyListValidWithIdsAsMarkdown = "# Dummy List Name\n\n- [ ] Dummy Task 1\n- [ ] Dummy Task 2\n"
+var DummyListValidWithIdsAsTodoTxt = "Dummy Task 1 +Dummy_List_Name\nDummy Task 2 +Dummy_List_Name\n"
+var DummyInvalidImportMarkdown = "- [ ] Orphan\n# Dummy List Name\n- [ ] \n"
+var DummyListWithDueDatesAsICalendar = "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//luschnat-ziegler//toDoListAPI//EN\r\nCALSCALE:GREGORIAN\r\nBEGIN:VTODO\r\nUID:1234\r\nDTSTAMP:20210204T121048Z\r\nSUMMARY:Dummy Task 1\r\nDESCRIPTION:Bring milk\\, eggs\\; and flour\r\nCATEGORIES:Dummy List Name\r\nDUE:20210205T123000Z\r\nSTATUS:COMPLETED\r\nEND:VTODO\r\nEND:VCALENDAR\r\n"
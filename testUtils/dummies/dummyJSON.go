@@ -6,12 +6,15 @@
 
 package dummies
 
-var DummyListValidAsJSON = `{"id":"000000000000000000000000","name":"Dummy List Name","description":null,"tasks":[{"id":"","name":"Dummy Task 1","description":null},{"id":"","name":"Dummy Task 2","description":null}]}`
-var DummyListValidWithIdsAsJson = `{"id":"601be448b9b5e15374b1e842","name":"Dummy List Name","description":null,"tasks":[{"id":"1234","name":"Dummy Task 1","description":null},{"id":"3245","name":"Dummy Task 2","description":null}]}`
+var DummyListValidAsJSON = `{"id":"000000000000000000000000","name":"Dummy List Name","description":null,"tasks":[{"id":"","name":"Dummy Task 1","description":null,"done":false,"due":null},{"id":"","name":"Dummy Task 2","description":null,"done":false,"due":null}]}`
+var DummyListValidWithIdsAsJson = `{"id":"601be448b9b5e15374b1e842","name":"Dummy List Name","description":null,"tasks":[{"id":"1234","name":"Dummy Task 1","description":null,"done":false,"due":null},{"id":"3245","name":"Dummy Task 2","description":null,"done":false,"due":null}]}`
 var DummyRequestInvalidJSON = `{id":"601be448b9b5e15374b1e842","name":"Dummy List Name","description":null,"tasks":[{"id":"1234","name":"Dummy Task 1","description":null},{"id":"3245","name":"Dummy Task 2","description":null}]}`
-var DummyInternalErrorAsJSON = `{"message":"internal error"}`
-var DummyBadRequestErrorAsJSON = `{"message":"Body parsing error"}`
+var DummyInternalErrorAsJSON = `{"type":"urn:todolistapi:problem:internal_error","title":"Internal server error","status":500,"detail":"internal error","instance":"%s","code":"internal_error"}`
+var DummyBadRequestErrorAsJSON = `{"type":"urn:todolistapi:problem:malformed_body","title":"Malformed request body","status":400,"detail":"Body parsing error: invalid JSON: invalid character 'i' looking for beginning of object key string","instance":"%s","code":"malformed_body","offset":2}`
+var DummyInvalidIdErrorAsJSON = `{"type":"urn:todolistapi:problem:bad_request","title":"Bad request","status":400,"detail":"ID is invalid","instance":"%s","code":"bad_request"}`
 var DummyValidSaveListRequestAsJSON = `{"name":"Dummy List Name", "description":null, "tasks":[{"name":"Dummy Task 1","description":null},{"name":"Dummy Task 2","description":null}]}`
 var DummyInvalidSaveListRequestAsJSON = `{"name":"", "description":null, "tasks":[{"name":"Dummy Task 1","description":null},{"name":"Dummy Task 2","description":null}]}`
-var DummyValidationErrorAsJSON = `{"invalid_fields":{"name":"required"}}`
-var DummyExpectedInfoJSON = `{"1. GET /todos":"Returns an array of all todo lists","2. POST /todos":"Creates and saves new todo, returns the newly created resource","3. GET /todos/{id}":"Returns the todo list with the provided id, if existing","4. PUT /todos/{id}":"Overwrites the todo list with the provided id (if existing) with the provided new list.","5. DELETE /todos/{id}":"Deletes the todo list with the provided id, if existing"}`
+var DummyValidationErrorAsJSON = `{"type":"urn:todolistapi:problem:validation_failed","title":"Validation failed","status":400,"detail":"The request contains invalid fields","instance":"%s","code":"validation_failed","invalid_fields":{"name":"required"},"violations":[{"field":"name","rule":"required","message":"is required"}]}`
+var DummyExpectedInfoJSON = `{"1. GET /todos":"Returns an array of all todo lists","10. GET /todos/{id}/calendar.ics":"Returns the tasks of the todo list with the provided id that have a due date as iCalendar feed","11. PUT /todos/{id}/collaborators/{subject}":"Shares the todo list with the provided id with a subject or changes its role (viewer, editor or owner), owners only","12. DELETE /todos/{id}/collaborators/{subject}":"Stops sharing the todo list with the provided id with a subject, owners only","13. GET /workspaces":"Returns an array of all workspaces the caller owns or is a member of","14. POST /workspaces":"Creates a new workspace owned by the caller, returns the newly created resource","15. GET /workspaces/{id}":"Returns the workspace with the provided id, if the caller belongs to it","16. PUT /workspaces/{id}":"Overwrites name and members of the workspace with the provided id, owners only","17. DELETE /workspaces/{id}":"Deletes the workspace with the provided id, owners only","18. POST /todos/{id}/share-links":"Creates a read-only share link for the todo list with the provided id (optional body: expiresAt), returns its token once, owners only","19. GET /todos/{id}/share-links":"Returns an array of the active share links of the todo list with the provided id, owners only","2. POST /todos":"Creates and saves new todo, returns the newly created resource","20. DELETE /todos/{id}/share-links/{linkId}":"Revokes the share link with the provided link id, owners only","21. GET /shared/{token}":"Returns the todo list shared by the provided token without authentication, if the link is active","3. GET /todos/{id}":"Returns the todo list with the provided id, if existing","4. PUT /todos/{id}":"Overwrites the todo list with the provided id (if existing) with the provided new list.","5. DELETE /todos/{id}":"Deletes the todo list with the provided id, if existing","6. GET /todos/export":"Returns all todo lists as a file (query parameter format: csv, markdown or todotxt)","7. GET /todos/{id}/export":"Returns the todo list with the provided id as a file (query parameter format: csv, markdown or todotxt)","8. POST /todos/import":"Creates and saves the todo lists contained in a csv, markdown or todotxt document, returns the newly created resources","9. GET /calendar.ics":"Returns the tasks of all todo lists that have a due date as iCalendar feed"}`
+var DummyUnsupportedFormatErrorAsJSON = `{"type":"urn:todolistapi:problem:bad_request","title":"Bad request","status":400,"detail":"Unsupported export format \"pdf\", expected one of: csv, markdown, todotxt","instance":"%s","code":"bad_request"}`
+var DummyImportErrorAsJSON = `{"type":"urn:todolistapi:problem:import_failed","title":"Import failed","status":400,"detail":"Import failed, no lists were saved","instance":"%s","code":"import_failed","parse_errors":[{"line":1,"message":"Checklist item outside of a list, expected a heading first"}],"invalid_lists":[{"line":2,"name":"Dummy List Name","invalid_fields":{"tasks[0].name":"required"},"violations":[{"field":"tasks[0].name","rule":"required","message":"is required"}]}]}`
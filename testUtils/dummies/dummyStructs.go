@@ -10,6 +10,7 @@ import (
 	"github.com/luschnat-ziegler/toDoListAPI/core/domain"
 	"github.com/luschnat-ziegler/toDoListAPI/errs"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
 )
 
 var DummyListValid = domain.ToDoList{
@@ -30,7 +31,9 @@ var DummyListValid = domain.ToDoList{
 	},
 }
 
-var objectId, _ = primitive.ObjectIDFromHex("601be448b9b5e15374b1e842")
+var DummyListIdHex = "601be448b9b5e15374b1e842"
+var objectId, _ = primitive.ObjectIDFromHex(DummyListIdHex)
+var DummyListId = domain.ListId(objectId)
 var DummyListValidWithIds = domain.ToDoList{
 	Id:          objectId,
 	Name:        "Dummy List Name",
@@ -68,3 +71,25 @@ var DummyListInvalid = domain.ToDoList{
 }
 
 var DummyInternalError = errs.NewInternalError("internal error")
+
+var dummyDescription = "Bring milk, eggs; and flour"
+var dummyDue = time.Date(2021, time.February, 5, 12, 30, 0, 0, time.UTC)
+var DummyListWithDueDates = domain.ToDoList{
+	Id:          objectId,
+	Name:        "Dummy List Name",
+	Description: nil,
+	Tasks: []domain.Task{
+		{
+			Id:          "1234",
+			Name:        "Dummy Task 1",
+			Description: &dummyDescription,
+			Done:        true,
+			Due:         &dummyDue,
+		},
+		{
+			Id:          "3245",
+			Name:        "Dummy Task 2",
+			Description: nil,
+		},
+	},
+}
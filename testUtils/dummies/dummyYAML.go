@@ -0,0 +1,29 @@
+/*
+ * package: dummies
+ * --------------------
+ * Provides dummy data for unit testing
+ */
+
+package dummies
+
+var DummyListValidWithIdsAsYAML = `description: null
+id: 601be448b9b5e15374b1e842
+name: Dummy List Name
+tasks:
+    - description: null
+      done: false
+      due: null
+      id: "1234"
+      name: Dummy Task 1
+    - description: null
+      done: false
+      due: null
+      id: "3245"
+      name: Dummy Task 2
+`
+var DummyValidSaveListRequestAsYAML = `name: Dummy List Name
+description: null
+tasks:
+  - name: Dummy Task 1
+  - name: Dummy Task 2
+`
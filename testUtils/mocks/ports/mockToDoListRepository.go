@@ -5,6 +5,7 @@
 package ports
 
 import (
+	context "context"
 	gomock "github.com/golang/mock/gomock"
 	domain "github.com/luschnat-ziegler/toDoListAPI/core/domain"
 	errs "github.com/luschnat-ziegler/toDoListAPI/errs"
@@ -35,75 +36,148 @@ func (m *MockToDoListRepository) EXPECT() *MockToDoListRepositoryMockRecorder {
 }
 
 // DeleteOneById mocks base method
-func (m *MockToDoListRepository) DeleteOneById(arg0 string) *errs.AppError {
+func (m *MockToDoListRepository) DeleteOneById(arg0 context.Context, arg1 string, arg2 domain.ListId) *errs.AppError {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteOneById", arg0)
+	ret := m.ctrl.Call(m, "DeleteOneById", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*errs.AppError)
 	return ret0
 }
 
 // DeleteOneById indicates an expected call of DeleteOneById
-func (mr *MockToDoListRepositoryMockRecorder) DeleteOneById(arg0 interface{}) *gomock.Call {
+func (mr *MockToDoListRepositoryMockRecorder) DeleteOneById(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOneById", reflect.TypeOf((*MockToDoListRepository)(nil).DeleteOneById), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOneById", reflect.TypeOf((*MockToDoListRepository)(nil).DeleteOneById), arg0, arg1, arg2)
+}
+
+// ForEach mocks base method
+func (m *MockToDoListRepository) ForEach(arg0 context.Context, arg1 func(domain.ToDoList) error) *errs.AppError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForEach", arg0, arg1)
+	ret0, _ := ret[0].(*errs.AppError)
+	return ret0
+}
+
+// ForEach indicates an expected call of ForEach
+func (mr *MockToDoListRepositoryMockRecorder) ForEach(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForEach", reflect.TypeOf((*MockToDoListRepository)(nil).ForEach), arg0, arg1)
 }
 
 // GetAll mocks base method
-func (m *MockToDoListRepository) GetAll() (*[]domain.ToDoList, *errs.AppError) {
+func (m *MockToDoListRepository) GetAll(arg0 context.Context, arg1 string) (*[]domain.ToDoList, *errs.AppError) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll")
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
 	ret0, _ := ret[0].(*[]domain.ToDoList)
 	ret1, _ := ret[1].(*errs.AppError)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll
-func (mr *MockToDoListRepositoryMockRecorder) GetAll() *gomock.Call {
+func (mr *MockToDoListRepositoryMockRecorder) GetAll(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockToDoListRepository)(nil).GetAll))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockToDoListRepository)(nil).GetAll), arg0, arg1)
 }
 
 // GetOneById mocks base method
-func (m *MockToDoListRepository) GetOneById(arg0 string) (*domain.ToDoList, *errs.AppError) {
+func (m *MockToDoListRepository) GetOneById(arg0 context.Context, arg1 string, arg2 domain.ListId) (*domain.ToDoList, *errs.AppError) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOneById", arg0)
+	ret := m.ctrl.Call(m, "GetOneById", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*domain.ToDoList)
 	ret1, _ := ret[1].(*errs.AppError)
 	return ret0, ret1
 }
 
 // GetOneById indicates an expected call of GetOneById
-func (mr *MockToDoListRepositoryMockRecorder) GetOneById(arg0 interface{}) *gomock.Call {
+func (mr *MockToDoListRepositoryMockRecorder) GetOneById(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOneById", reflect.TypeOf((*MockToDoListRepository)(nil).GetOneById), arg0, arg1, arg2)
+}
+
+// GetOneSharedById mocks base method
+func (m *MockToDoListRepository) GetOneSharedById(arg0 context.Context, arg1 domain.ListId) (*domain.ToDoList, *errs.AppError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOneSharedById", arg0, arg1)
+	ret0, _ := ret[0].(*domain.ToDoList)
+	ret1, _ := ret[1].(*errs.AppError)
+	return ret0, ret1
+}
+
+// GetOneSharedById indicates an expected call of GetOneSharedById
+func (mr *MockToDoListRepositoryMockRecorder) GetOneSharedById(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOneSharedById", reflect.TypeOf((*MockToDoListRepository)(nil).GetOneSharedById), arg0, arg1)
+}
+
+// Ping mocks base method
+func (m *MockToDoListRepository) Ping(arg0 context.Context) *errs.AppError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", arg0)
+	ret0, _ := ret[0].(*errs.AppError)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping
+func (mr *MockToDoListRepositoryMockRecorder) Ping(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOneById", reflect.TypeOf((*MockToDoListRepository)(nil).GetOneById), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockToDoListRepository)(nil).Ping), arg0)
+}
+
+// Restore mocks base method
+func (m *MockToDoListRepository) Restore(arg0 context.Context, arg1 domain.ToDoList, arg2 bool) (bool, *errs.AppError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(*errs.AppError)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore
+func (mr *MockToDoListRepositoryMockRecorder) Restore(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockToDoListRepository)(nil).Restore), arg0, arg1, arg2)
 }
 
 // Save mocks base method
-func (m *MockToDoListRepository) Save(arg0 domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
+func (m *MockToDoListRepository) Save(arg0 context.Context, arg1 domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Save", arg0)
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
 	ret0, _ := ret[0].(*domain.ToDoList)
 	ret1, _ := ret[1].(*errs.AppError)
 	return ret0, ret1
 }
 
 // Save indicates an expected call of Save
-func (mr *MockToDoListRepositoryMockRecorder) Save(arg0 interface{}) *gomock.Call {
+func (mr *MockToDoListRepositoryMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockToDoListRepository)(nil).Save), arg0, arg1)
+}
+
+// UpdateCollaborators mocks base method
+func (m *MockToDoListRepository) UpdateCollaborators(arg0 context.Context, arg1 string, arg2 domain.ListId, arg3 []domain.Collaborator) (*domain.ToDoList, *errs.AppError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCollaborators", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.ToDoList)
+	ret1, _ := ret[1].(*errs.AppError)
+	return ret0, ret1
+}
+
+// UpdateCollaborators indicates an expected call of UpdateCollaborators
+func (mr *MockToDoListRepositoryMockRecorder) UpdateCollaborators(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockToDoListRepository)(nil).Save), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCollaborators", reflect.TypeOf((*MockToDoListRepository)(nil).UpdateCollaborators), arg0, arg1, arg2, arg3)
 }
 
 // UpdateOneById mocks base method
-func (m *MockToDoListRepository) UpdateOneById(arg0 string, arg1 domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
+func (m *MockToDoListRepository) UpdateOneById(arg0 context.Context, arg1 string, arg2 domain.ListId, arg3 domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateOneById", arg0, arg1)
+	ret := m.ctrl.Call(m, "UpdateOneById", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*domain.ToDoList)
 	ret1, _ := ret[1].(*errs.AppError)
 	return ret0, ret1
 }
 
 // UpdateOneById indicates an expected call of UpdateOneById
-func (mr *MockToDoListRepositoryMockRecorder) UpdateOneById(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockToDoListRepositoryMockRecorder) UpdateOneById(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOneById", reflect.TypeOf((*MockToDoListRepository)(nil).UpdateOneById), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOneById", reflect.TypeOf((*MockToDoListRepository)(nil).UpdateOneById), arg0, arg1, arg2, arg3)
 }
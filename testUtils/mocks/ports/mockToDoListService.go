@@ -5,6 +5,7 @@
 package ports
 
 import (
+	context "context"
 	gomock "github.com/golang/mock/gomock"
 	domain "github.com/luschnat-ziegler/toDoListAPI/core/domain"
 	errs "github.com/luschnat-ziegler/toDoListAPI/errs"
@@ -35,75 +36,104 @@ func (m *MockToDoListService) EXPECT() *MockToDoListServiceMockRecorder {
 }
 
 // DeleteList mocks base method
-func (m *MockToDoListService) DeleteListById(arg0 string) *errs.AppError {
+func (m *MockToDoListService) DeleteListById(arg0 context.Context, arg1 string) *errs.AppError {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteListById", arg0)
+	ret := m.ctrl.Call(m, "DeleteListById", arg0, arg1)
 	ret0, _ := ret[0].(*errs.AppError)
 	return ret0
 }
 
 // DeleteList indicates an expected call of DeleteList
-func (mr *MockToDoListServiceMockRecorder) DeleteList(arg0 interface{}) *gomock.Call {
+func (mr *MockToDoListServiceMockRecorder) DeleteList(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteListById", reflect.TypeOf((*MockToDoListService)(nil).DeleteListById), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteListById", reflect.TypeOf((*MockToDoListService)(nil).DeleteListById), arg0, arg1)
 }
 
 // GetAllLists mocks base method
-func (m *MockToDoListService) GetAllLists() (*[]domain.ToDoList, *errs.AppError) {
+func (m *MockToDoListService) GetAllLists(arg0 context.Context) (*[]domain.ToDoList, *errs.AppError) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllLists")
+	ret := m.ctrl.Call(m, "GetAllLists", arg0)
 	ret0, _ := ret[0].(*[]domain.ToDoList)
 	ret1, _ := ret[1].(*errs.AppError)
 	return ret0, ret1
 }
 
 // GetAllLists indicates an expected call of GetAllLists
-func (mr *MockToDoListServiceMockRecorder) GetAllLists() *gomock.Call {
+func (mr *MockToDoListServiceMockRecorder) GetAllLists(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllLists", reflect.TypeOf((*MockToDoListService)(nil).GetAllLists))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllLists", reflect.TypeOf((*MockToDoListService)(nil).GetAllLists), arg0)
 }
 
 // GetOneListById mocks base method
-func (m *MockToDoListService) GetOneListById(arg0 string) (*domain.ToDoList, *errs.AppError) {
+func (m *MockToDoListService) GetOneListById(arg0 context.Context, arg1 string) (*domain.ToDoList, *errs.AppError) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOneListById", arg0)
+	ret := m.ctrl.Call(m, "GetOneListById", arg0, arg1)
 	ret0, _ := ret[0].(*domain.ToDoList)
 	ret1, _ := ret[1].(*errs.AppError)
 	return ret0, ret1
 }
 
 // GetOneListById indicates an expected call of GetOneListById
-func (mr *MockToDoListServiceMockRecorder) GetOneListById(arg0 interface{}) *gomock.Call {
+func (mr *MockToDoListServiceMockRecorder) GetOneListById(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOneListById", reflect.TypeOf((*MockToDoListService)(nil).GetOneListById), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOneListById", reflect.TypeOf((*MockToDoListService)(nil).GetOneListById), arg0, arg1)
+}
+
+// RemoveCollaborator mocks base method
+func (m *MockToDoListService) RemoveCollaborator(arg0 context.Context, arg1 string, arg2 string) *errs.AppError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCollaborator", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*errs.AppError)
+	return ret0
+}
+
+// RemoveCollaborator indicates an expected call of RemoveCollaborator
+func (mr *MockToDoListServiceMockRecorder) RemoveCollaborator(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCollaborator", reflect.TypeOf((*MockToDoListService)(nil).RemoveCollaborator), arg0, arg1, arg2)
 }
 
 // SaveList mocks base method
-func (m *MockToDoListService) SaveList(arg0 domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
+func (m *MockToDoListService) SaveList(arg0 context.Context, arg1 domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SaveList", arg0)
+	ret := m.ctrl.Call(m, "SaveList", arg0, arg1)
 	ret0, _ := ret[0].(*domain.ToDoList)
 	ret1, _ := ret[1].(*errs.AppError)
 	return ret0, ret1
 }
 
 // SaveList indicates an expected call of SaveList
-func (mr *MockToDoListServiceMockRecorder) SaveList(arg0 interface{}) *gomock.Call {
+func (mr *MockToDoListServiceMockRecorder) SaveList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveList", reflect.TypeOf((*MockToDoListService)(nil).SaveList), arg0, arg1)
+}
+
+// SetCollaborator mocks base method
+func (m *MockToDoListService) SetCollaborator(arg0 context.Context, arg1 string, arg2 domain.Collaborator) (*domain.ToDoList, *errs.AppError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCollaborator", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.ToDoList)
+	ret1, _ := ret[1].(*errs.AppError)
+	return ret0, ret1
+}
+
+// SetCollaborator indicates an expected call of SetCollaborator
+func (mr *MockToDoListServiceMockRecorder) SetCollaborator(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveList", reflect.TypeOf((*MockToDoListService)(nil).SaveList), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCollaborator", reflect.TypeOf((*MockToDoListService)(nil).SetCollaborator), arg0, arg1, arg2)
 }
 
 // UpdateOneListById mocks base method
-func (m *MockToDoListService) UpdateOneListById(arg0 string, arg1 domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
+func (m *MockToDoListService) UpdateOneListById(arg0 context.Context, arg1 string, arg2 domain.ToDoList) (*domain.ToDoList, *errs.AppError) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateOneListById", arg0, arg1)
+	ret := m.ctrl.Call(m, "UpdateOneListById", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*domain.ToDoList)
 	ret1, _ := ret[1].(*errs.AppError)
 	return ret0, ret1
 }
 
 // UpdateOneListById indicates an expected call of UpdateOneListById
-func (mr *MockToDoListServiceMockRecorder) UpdateOneListById(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockToDoListServiceMockRecorder) UpdateOneListById(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOneListById", reflect.TypeOf((*MockToDoListService)(nil).UpdateOneListById), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOneListById", reflect.TypeOf((*MockToDoListService)(nil).UpdateOneListById), arg0, arg1, arg2)
 }
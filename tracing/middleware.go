@@ -0,0 +1,84 @@
+/*
+ * package: tracing
+ * --------------------
+ * Includes OpenTelemetry tracing setup, span helpers and the http middleware.
+ */
+
+package tracing
+
+import (
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+	"regexp"
+)
+
+var routeVariablePattern = regexp.MustCompile(`\{(\w+):[^/]+\}`)
+
+type statusRecorder struct {
+	http.ResponseWriter
+	code int
+}
+
+/*
+ * Method: statusRecorder.WriteHeader
+ * --------------------
+ * Remembers the status code before passing it on to the wrapped http.ResponseWriter.
+ *
+ * code: the status code of the response
+ *
+ * returns: nothing
+ */
+
+func (sr *statusRecorder) WriteHeader(code int) {
+	sr.code = code
+	sr.ResponseWriter.WriteHeader(code)
+}
+
+/*
+ * Function: Middleware
+ * --------------------
+ * Wraps a handler to serve every request within a server span named after method and route template, e.g.
+ * "PUT /todos/{id}" (patterns of route variables are stripped). A W3C traceparent header sent by the client
+ * continues its trace. Responses with status codes of 500 and above mark the span as failed. To be registered with
+ * mux.Router.Use.
+ *
+ * next: the http.Handler to be wrapped
+ *
+ * returns: the wrapping http.Handler
+ */
+
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+		route := r.URL.Path
+		if current := mux.CurrentRoute(r); current != nil {
+			if template, err := current.GetPathTemplate(); err == nil {
+				route = routeVariablePattern.ReplaceAllString(template, "{$1}")
+			}
+		}
+
+		ctx, span := otel.Tracer(instrumentationName).Start(ctx, r.Method+" "+route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(r.Method),
+				semconv.HTTPRoute(route),
+				semconv.URLPath(r.URL.Path),
+			),
+		)
+		defer span.End()
+
+		recorder := &statusRecorder{ResponseWriter: w, code: http.StatusOK}
+		next.ServeHTTP(recorder, r.WithContext(ctx))
+
+		span.SetAttributes(semconv.HTTPResponseStatusCode(recorder.code))
+		if recorder.code >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(recorder.code))
+		}
+	})
+}
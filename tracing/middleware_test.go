@@ -0,0 +1,118 @@
+/*
+ * package: tracing
+ * --------------------
+ * Includes OpenTelemetry tracing setup, span helpers and the http middleware.
+ */
+
+package tracing
+
+import (
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+/*
+ * function: recordSpans
+ * --------------------
+ * Installs a tracer provider recording all spans in memory and the W3C trace context propagator for the duration
+ * of a test.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: a pointer to the tracetest.SpanRecorder
+ */
+
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	previousProvider := otel.GetTracerProvider()
+	previousPropagator := otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	})
+	return recorder
+}
+
+/*
+ * function: Test_Middleware_should_continue_trace_from_traceparent
+ * --------------------
+ * Tests if the middleware serves a request within a server span named after method and route template that
+ * continues the trace given by the traceparent header, and passes the span on to the handler.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Middleware_should_continue_trace_from_traceparent(t *testing.T) {
+	spans := recordSpans(t)
+
+	var handlerSpan trace.SpanContext
+	router := mux.NewRouter()
+	router.Use(Middleware)
+	router.HandleFunc("/todos/{id:[a-z_]+}", func(w http.ResponseWriter, r *http.Request) {
+		handlerSpan = trace.SpanContextFromContext(r.Context())
+	})
+
+	request, _ := http.NewRequest(http.MethodPut, "/todos/test_id", nil)
+	request.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	router.ServeHTTP(httptest.NewRecorder(), request)
+
+	ended := spans.Ended()
+	if len(ended) != 1 {
+		t.Fatalf("Expected 1 span, got %v instead", len(ended))
+	}
+	span := ended[0]
+
+	if span.Name() != "PUT /todos/{id}" {
+		t.Errorf("Expected span name PUT /todos/{id}, got %v instead", span.Name())
+	}
+	if span.SpanKind() != trace.SpanKindServer {
+		t.Errorf("Expected server span, got %v instead", span.SpanKind())
+	}
+	if span.SpanContext().TraceID().String() != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("Expected trace from traceparent, got %v instead", span.SpanContext().TraceID())
+	}
+	if span.Parent().SpanID().String() != "00f067aa0ba902b7" {
+		t.Errorf("Expected parent from traceparent, got %v instead", span.Parent().SpanID())
+	}
+	if handlerSpan.SpanID() != span.SpanContext().SpanID() {
+		t.Error("Expected handler to run within the server span")
+	}
+}
+
+/*
+ * function: Test_Middleware_should_mark_server_errors
+ * --------------------
+ * Tests if the middleware marks the span as failed if the handler writes a status code of 500 or above.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Middleware_should_mark_server_errors(t *testing.T) {
+	spans := recordSpans(t)
+
+	handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+
+	request, _ := http.NewRequest(http.MethodGet, "/todos", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), request)
+
+	ended := spans.Ended()
+	if len(ended) != 1 || ended[0].Status().Code != codes.Error {
+		t.Errorf("Expected 1 failed span, got %v", ended)
+	}
+}
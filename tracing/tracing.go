@@ -0,0 +1,123 @@
+/*
+ * package: tracing
+ * --------------------
+ * Includes OpenTelemetry tracing setup, span helpers and the http middleware.
+ */
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"github.com/luschnat-ziegler/toDoListAPI/config"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"io"
+	"net/http"
+	"os"
+)
+
+const (
+	ServiceName         = "toDoListAPI"
+	instrumentationName = "github.com/luschnat-ziegler/toDoListAPI"
+)
+
+/*
+ * Function: Setup
+ * --------------------
+ * Installs the global tracer provider with the configured exporter ("stdout" writes spans as JSON to os.Stdout,
+ * "otlp" sends them via OTLP/HTTP to the configured endpoint, "none" disables tracing) and sample ratio, and the
+ * W3C trace context and baggage propagators. Spans are propagated even if tracing is disabled.
+ *
+ * settings: the config.Tracing to be applied
+ *
+ * returns: a function flushing and stopping the exporter, to be called before the application exits, and nil.
+ *          Otherwise, nil and an error are returned.
+ */
+
+func Setup(settings config.Tracing) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch settings.Exporter {
+	case "stdout":
+		exporter, err = newStdoutExporter(os.Stdout)
+	case "otlp":
+		exporter, err = otlptracehttp.New(context.Background(), otlptracehttp.WithEndpointURL(settings.Endpoint))
+	default:
+		return func(context.Context) error { return nil }, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("creating %s trace exporter: %w", settings.Exporter, err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(settings.SampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(ServiceName))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+/*
+ * Function: newStdoutExporter
+ * --------------------
+ * Instantiates an exporter writing spans as JSON, one per line, e.g. to inspect traces without a collector.
+ *
+ * w: the io.Writer to write the spans to
+ *
+ * returns: the sdktrace.SpanExporter and an error or nil
+ */
+
+func newStdoutExporter(w io.Writer) (sdktrace.SpanExporter, error) {
+	return stdouttrace.New(stdouttrace.WithWriter(w))
+}
+
+/*
+ * Function: Start
+ * --------------------
+ * Starts a span as child of the span in ctx (if any) using the global tracer provider.
+ *
+ * ctx: the parent context.Context
+ * name: the name of the span, e.g. "ToDoListService.GetAllLists"
+ * attributes: any number of attribute.KeyValue to be set on the span
+ *
+ * returns: a context.Context carrying the new span and the span itself
+ */
+
+func Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+/*
+ * Function: End
+ * --------------------
+ * Ends a span, recording the result of the traced operation. Any error code is set as attribute "app.error.code";
+ * codes of 500 and above mark the span as failed.
+ *
+ * span: the trace.Span to be ended
+ * appErr: the pointer to errs.AppError returned by the operation, nil on success
+ *
+ * returns: nothing
+ */
+
+func End(span trace.Span, appErr *errs.AppError) {
+	if appErr != nil {
+		span.SetAttributes(attribute.Int("app.error.code", appErr.Code))
+		if appErr.Code >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, appErr.Message)
+		}
+	}
+	span.End()
+}
@@ -0,0 +1,96 @@
+/*
+ * package: tracing
+ * --------------------
+ * Includes OpenTelemetry tracing setup, span helpers and the http middleware.
+ */
+
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"github.com/luschnat-ziegler/toDoListAPI/config"
+	"github.com/luschnat-ziegler/toDoListAPI/errs"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"strings"
+	"testing"
+)
+
+/*
+ * function: Test_End_should_mark_only_server_errors_as_failed
+ * --------------------
+ * Tests if End records the error code of any errs.AppError but only marks spans with codes of 500 and above as
+ * failed.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_End_should_mark_only_server_errors_as_failed(t *testing.T) {
+	spans := recordSpans(t)
+
+	_, notFound := Start(context.Background(), "notFound")
+	End(notFound, errs.NewNotFoundError("not found"))
+	_, internal := Start(context.Background(), "internal")
+	End(internal, errs.NewInternalError("internal error"))
+
+	ended := spans.Ended()
+	if len(ended) != 2 {
+		t.Fatalf("Expected 2 spans, got %v instead", len(ended))
+	}
+	if ended[0].Status().Code == codes.Error || ended[0].Attributes()[0].Value.AsInt64() != 404 {
+		t.Errorf("Expected unfailed span with error code 404, got %v and %v", ended[0].Status(), ended[0].Attributes())
+	}
+	if ended[1].Status().Code != codes.Error || ended[1].Status().Description != "internal error" {
+		t.Errorf("Expected failed span, got %v instead", ended[1].Status())
+	}
+}
+
+/*
+ * function: Test_newStdoutExporter_should_write_spans_as_json
+ * --------------------
+ * Tests if the stdout exporter writes ended spans including their name as JSON.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_newStdoutExporter_should_write_spans_as_json(t *testing.T) {
+	var buffer bytes.Buffer
+	exporter, err := newStdoutExporter(&buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	_, span := provider.Tracer("test").Start(context.Background(), "ToDoListService.GetAllLists")
+	span.End()
+	_ = provider.Shutdown(context.Background())
+
+	if !strings.Contains(buffer.String(), `"Name":"ToDoListService.GetAllLists"`) {
+		t.Errorf("Expected span in output, got %q instead", buffer.String())
+	}
+}
+
+/*
+ * function: Test_Setup_should_accept_disabled_exporter
+ * --------------------
+ * Tests if Setup succeeds without installing an exporter if tracing is disabled.
+ *
+ * t: a pointer to testing.T to meet test function signature requirements.
+ *
+ * Returns: nothing
+ */
+
+func Test_Setup_should_accept_disabled_exporter(t *testing.T) {
+	shutdown, err := Setup(config.Default().Tracing)
+	if err != nil {
+		t.Fatalf("Expected nil, got error %v instead", err)
+	}
+	if err := shutdown(context.Background()); err != nil {
+		t.Errorf("Expected nil, got error %v instead", err)
+	}
+}